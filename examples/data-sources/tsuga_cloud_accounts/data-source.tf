@@ -0,0 +1,8 @@
+data "tsuga_cloud_accounts" "aws" {
+  cloud_type = "aws"
+}
+
+# AWS account IDs of every connected account
+output "aws_account_ids" {
+  value = [for a in data.tsuga_cloud_accounts.aws.cloud_accounts : a.cloud_account_id]
+}
@@ -0,0 +1,11 @@
+# List every cluster of the organization
+data "tsuga_clusters" "all" {}
+
+# List the clusters of a region, for example to pass to `cluster_ids`
+data "tsuga_clusters" "us" {
+  region = "us-east-1"
+}
+
+output "us_cluster_ids" {
+  value = data.tsuga_clusters.us.ids
+}
@@ -0,0 +1,12 @@
+data "tsuga_grok_parse" "access_log" {
+  rules = [
+    "%%{IPORHOST:network.client.ip} %%{WORD:http.method} %%{URIPATHPARAM:http.url} %%{INT:http.status_code:integer}",
+  ]
+  samples = [
+    "10.0.0.12 GET /api/v1/orders?limit=10 200",
+  ]
+}
+
+output "status_code" {
+  value = jsondecode(data.tsuga_grok_parse.access_log.results[0].extracted).http.status_code
+}
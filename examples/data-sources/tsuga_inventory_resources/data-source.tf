@@ -0,0 +1,13 @@
+# EC2 instances in one AWS account
+data "tsuga_inventory_resources" "instances" {
+  cloud_platforms       = ["aws"]
+  cloud_accounts        = ["123456789012"]
+  native_resource_types = ["aws_ec2_instance"]
+}
+
+output "instance_types" {
+  value = {
+    for r in data.tsuga_inventory_resources.instances.resources :
+    r.display_name => jsondecode(r.attributes).instanceType
+  }
+}
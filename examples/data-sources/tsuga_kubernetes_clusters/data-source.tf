@@ -0,0 +1,8 @@
+# Kubernetes clusters whose name contains "prod"
+data "tsuga_kubernetes_clusters" "production" {
+  search = "prod"
+}
+
+output "production_clusters" {
+  value = data.tsuga_kubernetes_clusters.production.names
+}
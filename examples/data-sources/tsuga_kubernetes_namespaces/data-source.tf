@@ -0,0 +1,36 @@
+# Namespaces of the prod-east Kubernetes cluster
+data "tsuga_kubernetes_namespaces" "prod_east" {
+  kubernetes_cluster = "prod-east"
+}
+
+# One error log monitor per namespace
+resource "tsuga_monitor" "namespace_errors" {
+  for_each = toset(data.tsuga_kubernetes_namespaces.prod_east.names)
+
+  name        = "Errors in ${each.key}"
+  owner       = "abc-123-def"
+  permissions = "all"
+  priority    = 3
+  message     = "Namespace ${each.key} is logging errors."
+
+  configuration = {
+    log = {
+      queries = [
+        {
+          filter = "context.k8s.namespace.name:${each.key} AND level:error"
+          aggregate = {
+            count = {}
+          }
+        }
+      ]
+      conditions = [{
+        formula   = "q1"
+        operator  = "greater_than"
+        threshold = 100
+      }]
+      timeframe               = 10
+      no_data_behavior        = "resolve"
+      aggregation_alert_logic = "no_aggregation"
+    }
+  }
+}
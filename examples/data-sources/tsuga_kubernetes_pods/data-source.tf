@@ -0,0 +1,12 @@
+# Pods of the checkout workloads
+data "tsuga_kubernetes_pods" "checkout" {
+  search    = "checkout"
+  namespace = "payments"
+}
+
+output "crashing_pods" {
+  value = [
+    for p in data.tsuga_kubernetes_pods.checkout.pods : p.name
+    if contains(["CrashLoopBackOff", "Error"], p.phase)
+  ]
+}
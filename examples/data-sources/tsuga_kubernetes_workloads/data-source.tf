@@ -0,0 +1,13 @@
+# Deployments and StatefulSets of the payments namespace
+data "tsuga_kubernetes_workloads" "payments" {
+  kinds     = ["deployment", "statefulset"]
+  namespace = "payments"
+}
+
+# Workloads running below their desired replicas
+output "degraded_workloads" {
+  value = [
+    for w in data.tsuga_kubernetes_workloads.payments.workloads : "${w.kind}/${w.name}"
+    if w.ready_replicas != null && w.desired_replicas != null && w.ready_replicas < w.desired_replicas
+  ]
+}
@@ -0,0 +1,43 @@
+# Error patterns of the platform team first seen in production over the last week
+data "tsuga_log_patterns" "new_errors" {
+  type   = "new"
+  window = "7d"
+  team   = "platform"
+  env    = "production"
+}
+
+# Services with at least two new error patterns
+locals {
+  noisy_services = [
+    for service in data.tsuga_log_patterns.new_errors.services : service
+    if length([for p in data.tsuga_log_patterns.new_errors.patterns : p if p.service == service]) >= 2
+  ]
+}
+
+resource "tsuga_monitor" "new_error_patterns" {
+  for_each = toset(local.noisy_services)
+
+  name        = "New error patterns in ${each.key}"
+  owner       = "abc-123-def"
+  permissions = "all"
+  priority    = 3
+  message     = "A new error pattern was detected in ${each.key}."
+
+  configuration = {
+    log_error_pattern = {
+      aggregation_alert_logic = "each"
+      no_data_behavior        = "keep_last_status"
+      filter = {
+        team_ids = ["abc-123-def"]
+        env      = "production"
+        service  = each.key
+      }
+    }
+  }
+}
+
+# Most frequent patterns of the error logs of the last hour
+data "tsuga_log_patterns" "errors" {
+  window = "1h"
+  query  = "level:ERROR"
+}
@@ -0,0 +1,11 @@
+data "tsuga_metric" "memory" {
+  name = "k8s.node.memory.usage"
+}
+
+# Fail the plan if the metric stops being a gauge
+check "memory_is_gauge" {
+  assert {
+    condition     = data.tsuga_metric.memory.type == "gauge"
+    error_message = "k8s.node.memory.usage is a ${data.tsuga_metric.memory.type}, expected a gauge"
+  }
+}
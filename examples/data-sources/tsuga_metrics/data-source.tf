@@ -0,0 +1,10 @@
+# Kubernetes node gauges reported in the last week
+data "tsuga_metrics" "node_gauges" {
+  name_prefix = "k8s.node."
+  type        = "gauge"
+  window      = "7d"
+}
+
+output "node_gauges" {
+  value = data.tsuga_metrics.node_gauges.names
+}
@@ -0,0 +1,45 @@
+locals {
+  checkout_errors = {
+    log = {
+      conditions = [{
+        formula   = "q1"
+        operator  = "greater_than"
+        threshold = 50
+      }]
+      no_data_behavior = "resolve"
+      timeframe        = 15
+      group_by_fields = [{
+        fields = ["env"]
+        limit  = 10
+      }]
+      aggregation_alert_logic = "each"
+      queries = [{
+        filter = "service:checkout AND level:error"
+        aggregate = {
+          count = {}
+        }
+      }]
+    }
+  }
+}
+
+# How often the monitor would have fired over the last week
+data "tsuga_monitor_backtest" "checkout_errors" {
+  window        = "7d"
+  configuration = local.checkout_errors
+}
+
+# Warn before merging a monitor that would page more than twice a day
+check "checkout_errors_noise" {
+  assert {
+    condition     = data.tsuga_monitor_backtest.checkout_errors.trigger_count <= 14
+    error_message = "The monitor would have triggered ${data.tsuga_monitor_backtest.checkout_errors.trigger_count} times in the last 7 days"
+  }
+}
+
+resource "tsuga_monitor" "checkout_errors" {
+  name          = "Checkout errors"
+  priority      = 2
+  owner         = "abc-123-def"
+  configuration = local.checkout_errors
+}
@@ -0,0 +1,20 @@
+# Fail the plan when the telemetry of the payments team is not good enough to
+# onboard it
+data "tsuga_quality_report" "payments" {
+  team          = "payments"
+  minimum_score = 0.8
+  rule_assertions = [
+    { rule_id = "team-has-route" },
+    { rule_id = "service-name-present", minimum_score = 0.95 },
+  ]
+}
+
+# Only warn across the whole cluster, and list what to fix
+data "tsuga_quality_report" "cluster" {
+  minimum_score      = 0.7
+  assertion_severity = "warning"
+}
+
+output "quality_violations" {
+  value = data.tsuga_quality_report.cluster.violations
+}
@@ -0,0 +1,30 @@
+# p99 latency of the checkout service over the last 14 days
+data "tsuga_query_value" "checkout_p99" {
+  window = "14d"
+  source = "traces"
+  queries = [
+    {
+      filter = "service:checkout"
+      aggregate = {
+        percentile = {
+          field      = "duration"
+          percentile = 99
+        }
+      }
+    },
+  ]
+}
+
+# Alert at twice the baseline, for example in
+# configuration.trace.conditions[0].threshold of a tsuga_monitor
+locals {
+  checkout_latency_threshold = data.tsuga_query_value.checkout_p99.value * 2
+}
+
+# The same kind of baseline with PromQL
+data "tsuga_query_value" "cpu" {
+  window = "7d"
+  promql = {
+    query = "avg(avg_over_time(k8s_node_cpu_usage[7d]))"
+  }
+}
@@ -0,0 +1,23 @@
+# Simulate the processors of a route managed in the same configuration
+data "tsuga_route_simulation" "nginx" {
+  processors = tsuga_route.route.processors
+
+  events = [
+    jsonencode({
+      msg = "2026/08/13 10:00:00 [error] upstream timed out, client: 10.0.0.12, server: api, request: \"GET /orders HTTP/1.1\", host: \"api.example.com\""
+    }),
+  ]
+}
+
+output "simulated_event" {
+  value = jsondecode(data.tsuga_route_simulation.nginx.results[0].event)
+}
+
+# Simulate the processors of an existing route by ID
+data "tsuga_route_simulation" "existing" {
+  route_id = "abc-123-def"
+
+  events = [
+    jsonencode({ message = "GET /health 200", status_code = 200 }),
+  ]
+}
@@ -0,0 +1,9 @@
+data "tsuga_service" "checkout" {
+  id           = "abc-123-def"
+  graph_window = "24h"
+}
+
+# The services and databases checkout calls, for alerts on its dependencies
+output "checkout_dependencies" {
+  value = data.tsuga_service.checkout.dependencies
+}
@@ -0,0 +1,35 @@
+# Go services seen in the last week
+data "tsuga_services" "go" {
+  language         = "go"
+  last_seen_within = "7d"
+}
+
+# One error-log monitor per service
+resource "tsuga_monitor" "service_errors" {
+  for_each = { for s in data.tsuga_services.go.services : s.name => s }
+
+  name        = "${each.key}: error logs"
+  owner       = "abc-123-def"
+  permissions = "all"
+  priority    = 2
+  configuration = {
+    log = {
+      queries = [
+        {
+          filter = "service:\"${each.key}\" AND level:error"
+          aggregate = {
+            count = {}
+          }
+        }
+      ]
+      conditions = [{
+        formula   = "q1"
+        operator  = "greater_than"
+        threshold = 100
+      }]
+      timeframe               = 10
+      no_data_behavior        = "resolve"
+      aggregation_alert_logic = "each"
+    }
+  }
+}
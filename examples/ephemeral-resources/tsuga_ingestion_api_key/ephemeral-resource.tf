@@ -0,0 +1,23 @@
+# A short-lived key for a smoke test run by the apply. The key is deleted when
+# the run ends, and a new one is created on every plan and apply.
+ephemeral "tsuga_ingestion_api_key" "smoke_test" {
+  name  = "collector-smoke-test"
+  owner = "abc-123-def"
+
+  tags = [
+    {
+      key   = "env"
+      value = "prod"
+    }
+  ]
+}
+
+# Provisioners accept ephemeral values, so the key never reaches state.
+resource "terraform_data" "smoke_test" {
+  provisioner "local-exec" {
+    command = "./scripts/send-test-log.sh"
+    environment = {
+      TSUGA_INGESTION_KEY = ephemeral.tsuga_ingestion_api_key.smoke_test.key
+    }
+  }
+}
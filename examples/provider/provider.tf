@@ -3,4 +3,10 @@
 provider "tsuga" {
   base_url = "https://api.tsuga.com"
   token    = "your-api-token"
+
+  # Optional default cluster for monitors, SLOs and telemetry requests (TSUGA_CLUSTER_ID)
+  # cluster_id = "abc-123-def"
+
+  # Optional plan-time checks of the metrics used by monitors and dashboards (TSUGA_VALIDATE_METRICS)
+  # validate_metrics = true
 }
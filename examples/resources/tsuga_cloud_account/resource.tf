@@ -1,7 +1,9 @@
 # AWS cloud account. The cross-account IAM role must already exist and trust Tsuga
-# with the given external ID before applying.
+# with the given external ID before applying. If the account was already connected
+# in the Tsuga UI, adopt_existing brings it under Terraform instead of failing.
 resource "tsuga_cloud_account" "aws_prod" {
   account_friendly_name = "Production AWS"
+  adopt_existing        = true
 
   aws = {
     account_id  = "123456789012"
@@ -9,3 +9,51 @@ resource "tsuga_ingestion_api_key" "example" {
     }
   ]
 }
+
+# Keep the secret out of state; only key_last_characters is recorded.
+resource "tsuga_ingestion_api_key" "metrics" {
+  name        = "production-metrics-ingestion"
+  owner       = "abc-123-def"
+  persist_key = false
+
+  tags = [
+    {
+      key   = "env"
+      value = "prod"
+    }
+  ]
+}
+
+# Store the key encrypted with a PGP key instead of in plaintext; read it with
+# terraform output -raw ci_key | base64 --decode | gpg --decrypt
+resource "tsuga_ingestion_api_key" "ci" {
+  name    = "ci-ingestion"
+  owner   = "abc-123-def"
+  pgp_key = "keybase:platform-team"
+
+  tags = [
+    {
+      key   = "env"
+      value = "ci"
+    }
+  ]
+}
+
+output "ci_key" {
+  value = tsuga_ingestion_api_key.ci.encrypted_key
+}
+
+# Replace the key every 90 days; the replaced key stays valid for 7 days.
+resource "tsuga_ingestion_api_key" "rotated" {
+  name             = "production-traces-ingestion"
+  owner            = "abc-123-def"
+  rotation_period  = "90d"
+  rotation_overlap = "7d"
+
+  tags = [
+    {
+      key   = "env"
+      value = "prod"
+    }
+  ]
+}
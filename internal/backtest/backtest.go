@@ -0,0 +1,291 @@
+// Package backtest replays the alerting logic of threshold monitors over past
+// timeseries, to estimate how often a monitor would have triggered.
+//
+// The monitor is evaluated once per step, the step being its timeframe: each
+// evaluation reads the value of every condition formula in one bucket of the
+// timeseries, applies the conditions to each group, handles groups without data as
+// the no-data behavior says, and combines the groups with the aggregation alert
+// logic. A trigger is an evaluation that alerts after one that did not. Monitors
+// evaluate a sliding timeframe every minute, so a backtest over buckets is an
+// approximation that can miss short breaches straddling two buckets.
+package backtest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Operators are the comparison operators of monitor conditions.
+var Operators = []string{"greater_than", "less_than", "equal", "not_equal", "greater_than_or_equal", "less_than_or_equal"}
+
+// Point is the value of a formula in the bucket starting at Time.
+type Point struct {
+	Time  time.Time
+	Value float64
+}
+
+// Series holds the points of a formula for one group. Group is empty when the
+// monitor is not grouped.
+type Series struct {
+	Group  map[string]string
+	Points []Point
+}
+
+// Condition compares the values of a formula, given as one series per group, with
+// a threshold.
+type Condition struct {
+	Operator  string
+	Threshold float64
+	Series    []Series
+}
+
+// Monitor is the alerting logic of a threshold monitor.
+type Monitor struct {
+	// Conditions must all match for a group to breach.
+	Conditions []Condition
+	// Grouped is whether the monitor has group_by_fields. An ungrouped monitor has
+	// a single group, which has no data when no series was returned.
+	Grouped bool
+	// AlertLogic is the aggregation_alert_logic: no_aggregation, all, any, each or
+	// proportion.
+	AlertLogic string
+	// ProportionThreshold is the percentage of groups that must alert when
+	// AlertLogic is proportion.
+	ProportionThreshold int64
+	// NoDataBehavior is alert, resolve, keep_last_status or consider_zero.
+	NoDataBehavior string
+}
+
+// Result is the outcome of a backtest.
+type Result struct {
+	// Evaluations is the number of times the monitor was evaluated.
+	Evaluations int
+	// Triggers are the times of the evaluations that started an alert, in order.
+	// With the each logic, every group alerts on its own and these are the
+	// triggers of all the groups.
+	Triggers []time.Time
+	// Groups are the groups seen in the series, ordered by their values.
+	Groups []GroupResult
+}
+
+// GroupResult is the outcome of a backtest for one group.
+type GroupResult struct {
+	Group map[string]string
+	// Breaches is the number of evaluations where every condition matched.
+	Breaches int
+	// NoData is the number of evaluations where a condition had no value.
+	NoData int
+	// Triggers are the times of the evaluations where the group started alerting.
+	Triggers []time.Time
+}
+
+type groupState struct {
+	key    string
+	labels map[string]string
+	// values and present are indexed by condition, then by bucket.
+	values   [][]float64
+	present  [][]bool
+	alerting bool
+	result   GroupResult
+}
+
+// Evaluate replays m over the buckets of length step that end at to, starting at
+// the first bucket that begins at or after from. Evaluations are reported at the
+// end of their bucket, when the monitor would have seen the whole timeframe.
+// Points outside the buckets are ignored.
+func Evaluate(m Monitor, from, to time.Time, step time.Duration) (Result, error) {
+	if err := m.validate(); err != nil {
+		return Result{}, err
+	}
+	if step <= 0 {
+		return Result{}, fmt.Errorf("the evaluation step must be positive, got %s", step)
+	}
+	n := int(to.Sub(from) / step)
+	if n < 1 {
+		return Result{}, fmt.Errorf("the time range from %s to %s is shorter than one evaluation step of %s", from.Format(time.RFC3339), to.Format(time.RFC3339), step)
+	}
+	start := to.Add(-time.Duration(n) * step)
+
+	groups := map[string]*groupState{}
+	group := func(labels map[string]string) *groupState {
+		key := groupKey(labels)
+		g, ok := groups[key]
+		if !ok {
+			g = &groupState{key: key, labels: labels}
+			for range m.Conditions {
+				g.values = append(g.values, make([]float64, n))
+				g.present = append(g.present, make([]bool, n))
+			}
+			groups[key] = g
+		}
+		return g
+	}
+	if !m.Grouped {
+		group(map[string]string{})
+	}
+	for c, cond := range m.Conditions {
+		for _, s := range cond.Series {
+			labels := s.Group
+			if !m.Grouped || labels == nil {
+				labels = map[string]string{}
+			}
+			g := group(labels)
+			for _, p := range s.Points {
+				if p.Time.Before(start) || !p.Time.Before(to) {
+					continue
+				}
+				i := int(p.Time.Sub(start) / step)
+				g.values[c][i] = p.Value
+				g.present[c][i] = true
+			}
+		}
+	}
+
+	ordered := make([]*groupState, 0, len(groups))
+	for _, g := range groups {
+		ordered = append(ordered, g)
+	}
+	sort.Slice(ordered, func(i, j int) bool { return ordered[i].key < ordered[j].key })
+
+	result := Result{Evaluations: n}
+	monitorAlerting := false
+	for i := 0; i < n; i++ {
+		at := start.Add(time.Duration(i+1) * step)
+		alertingGroups := 0
+		for _, g := range ordered {
+			alerting := m.evaluateGroup(g, i)
+			if alerting && !g.alerting {
+				g.result.Triggers = append(g.result.Triggers, at)
+			}
+			g.alerting = alerting
+			if alerting {
+				alertingGroups++
+			}
+		}
+
+		if m.AlertLogic == "each" {
+			continue
+		}
+		alerting := false
+		switch m.AlertLogic {
+		case "no_aggregation", "any":
+			alerting = alertingGroups > 0
+		case "all":
+			alerting = len(ordered) > 0 && alertingGroups == len(ordered)
+		case "proportion":
+			alerting = len(ordered) > 0 && int64(alertingGroups)*100 >= m.ProportionThreshold*int64(len(ordered))
+		}
+		if alerting && !monitorAlerting {
+			result.Triggers = append(result.Triggers, at)
+		}
+		monitorAlerting = alerting
+	}
+
+	for _, g := range ordered {
+		g.result.Group = g.labels
+		result.Groups = append(result.Groups, g.result)
+		if m.AlertLogic == "each" {
+			result.Triggers = append(result.Triggers, g.result.Triggers...)
+		}
+	}
+	if m.AlertLogic == "each" {
+		sort.Slice(result.Triggers, func(i, j int) bool { return result.Triggers[i].Before(result.Triggers[j]) })
+	}
+	return result, nil
+}
+
+// evaluateGroup returns whether g alerts at bucket i, and counts its breaches and
+// evaluations without data.
+func (m Monitor) evaluateGroup(g *groupState, i int) bool {
+	breach := true
+	for c, cond := range m.Conditions {
+		value := g.values[c][i]
+		if !g.present[c][i] && m.NoDataBehavior != "consider_zero" {
+			g.result.NoData++
+			switch m.NoDataBehavior {
+			case "alert":
+				return true
+			case "resolve":
+				return false
+			default:
+				return g.alerting
+			}
+		}
+		if !compare(cond.Operator, value, cond.Threshold) {
+			breach = false
+		}
+	}
+	if breach {
+		g.result.Breaches++
+	}
+	return breach
+}
+
+func (m Monitor) validate() error {
+	if len(m.Conditions) == 0 {
+		return fmt.Errorf("the monitor has no conditions")
+	}
+	for i, c := range m.Conditions {
+		if !contains(Operators, c.Operator) {
+			return fmt.Errorf("conditions[%d]: unknown operator %q", i, c.Operator)
+		}
+	}
+	switch m.AlertLogic {
+	case "no_aggregation", "all", "any", "each":
+	case "proportion":
+		if m.ProportionThreshold < 1 || m.ProportionThreshold > 99 {
+			return fmt.Errorf("the proportion threshold must be between 1 and 99, got %d", m.ProportionThreshold)
+		}
+	default:
+		return fmt.Errorf("unknown aggregation alert logic %q", m.AlertLogic)
+	}
+	switch m.NoDataBehavior {
+	case "alert", "resolve", "keep_last_status", "consider_zero":
+	default:
+		return fmt.Errorf("unknown no-data behavior %q", m.NoDataBehavior)
+	}
+	return nil
+}
+
+func compare(operator string, value, threshold float64) bool {
+	switch operator {
+	case "greater_than":
+		return value > threshold
+	case "less_than":
+		return value < threshold
+	case "equal":
+		return value == threshold
+	case "not_equal":
+		return value != threshold
+	case "greater_than_or_equal":
+		return value >= threshold
+	case "less_than_or_equal":
+		return value <= threshold
+	}
+	return false
+}
+
+// groupKey identifies a group by its values, ordered by field name.
+func groupKey(labels map[string]string) string {
+	fields := make([]string, 0, len(labels))
+	for k := range labels {
+		fields = append(fields, k)
+	}
+	sort.Strings(fields)
+	var b strings.Builder
+	for _, k := range fields {
+		fmt.Fprintf(&b, "%q=%q,", k, labels[k])
+	}
+	return b.String()
+}
+
+func contains(values []string, v string) bool {
+	for _, s := range values {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,210 @@
+package backtest
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+var (
+	start = time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	step  = 10 * time.Minute
+	end   = start.Add(6 * step)
+	gap   = math.NaN()
+)
+
+// series returns the series of a group with one point per bucket from start, a
+// NaN value leaving its bucket without data.
+func series(group map[string]string, values ...float64) Series {
+	s := Series{Group: group}
+	for i, v := range values {
+		if !math.IsNaN(v) {
+			s.Points = append(s.Points, Point{Time: start.Add(time.Duration(i) * step), Value: v})
+		}
+	}
+	return s
+}
+
+// at returns the end of the i-th bucket, when its evaluation is reported.
+func at(buckets ...int) []time.Time {
+	var out []time.Time
+	for _, i := range buckets {
+		out = append(out, start.Add(time.Duration(i+1)*step))
+	}
+	return out
+}
+
+func above(threshold float64, s ...Series) Condition {
+	return Condition{Operator: "greater_than", Threshold: threshold, Series: s}
+}
+
+func TestEvaluateUngrouped(t *testing.T) {
+	cases := []struct {
+		name     string
+		noData   string
+		values   []float64
+		triggers []time.Time
+		breaches int
+		noDatas  int
+	}{
+		{"no breach", "resolve", []float64{1, 2, 3, 4, 5, 6}, nil, 0, 0},
+		{"one long breach", "resolve", []float64{1, 20, 30, 40, 5, 6}, at(1), 3, 0},
+		{"two breaches", "resolve", []float64{20, 1, 30, 40, 5, 60}, at(0, 2, 5), 4, 0},
+		{"no data alerts", "alert", []float64{1, gap, 3, 4, gap, gap}, at(1, 4), 0, 3},
+		{"no data resolves", "resolve", []float64{20, gap, 30, gap, 5, 6}, at(0, 2), 2, 2},
+		{"no data keeps the last status", "keep_last_status", []float64{20, gap, 30, gap, 5, 60}, at(0, 5), 3, 2},
+		{"no data is zero", "consider_zero", []float64{gap, gap, gap, gap, gap, gap}, nil, 0, 0},
+		{"no series", "alert", nil, at(0), 0, 6},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var s []Series
+			if tc.values != nil {
+				s = append(s, series(nil, tc.values...))
+			}
+			m := Monitor{Conditions: []Condition{above(10, s...)}, AlertLogic: "no_aggregation", NoDataBehavior: tc.noData}
+
+			got, err := Evaluate(m, start, end, step)
+			if err != nil {
+				t.Fatalf("Evaluate() returned error: %s", err)
+			}
+			if got.Evaluations != 6 {
+				t.Errorf("Evaluations = %d, want 6", got.Evaluations)
+			}
+			if !reflect.DeepEqual(got.Triggers, tc.triggers) {
+				t.Errorf("Triggers = %v, want %v", got.Triggers, tc.triggers)
+			}
+			if len(got.Groups) != 1 || got.Groups[0].Breaches != tc.breaches || got.Groups[0].NoData != tc.noDatas {
+				t.Errorf("Groups = %+v, want one group with %d breaches and %d evaluations without data", got.Groups, tc.breaches, tc.noDatas)
+			}
+		})
+	}
+}
+
+func TestEvaluateConditionsMustAllMatch(t *testing.T) {
+	m := Monitor{
+		Conditions: []Condition{
+			above(10, series(nil, 20, 20, 1, 20, 20, 20)),
+			{Operator: "less_than_or_equal", Threshold: 5, Series: []Series{series(nil, 1, 9, 1, 1, 1, 9)}},
+		},
+		AlertLogic:     "no_aggregation",
+		NoDataBehavior: "resolve",
+	}
+
+	got, err := Evaluate(m, start, end, step)
+	if err != nil {
+		t.Fatalf("Evaluate() returned error: %s", err)
+	}
+	if want := at(0, 3); !reflect.DeepEqual(got.Triggers, want) {
+		t.Errorf("Triggers = %v, want %v", got.Triggers, want)
+	}
+}
+
+func TestEvaluateGrouped(t *testing.T) {
+	api := map[string]string{"service": "api"}
+	web := map[string]string{"service": "web"}
+	worker := map[string]string{"service": "worker"}
+	s := []Series{
+		series(web, 1, 20, 20, 1, 20, 1),
+		series(api, 20, 20, 1, 1, 20, 20),
+		series(worker, 1, 20, 1, 1, 20, gap),
+	}
+
+	cases := []struct {
+		logic      string
+		proportion int64
+		triggers   []time.Time
+	}{
+		{"any", 0, at(0, 4)},
+		{"all", 0, at(1, 4)},
+		{"proportion", 60, at(1, 4)},
+		{"proportion", 30, at(0, 4)},
+		{"each", 0, at(0, 1, 1, 4, 4, 4)},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.logic, func(t *testing.T) {
+			m := Monitor{
+				Conditions:          []Condition{above(10, s...)},
+				Grouped:             true,
+				AlertLogic:          tc.logic,
+				ProportionThreshold: tc.proportion,
+				NoDataBehavior:      "resolve",
+			}
+
+			got, err := Evaluate(m, start, end, step)
+			if err != nil {
+				t.Fatalf("Evaluate() returned error: %s", err)
+			}
+			if !reflect.DeepEqual(got.Triggers, tc.triggers) {
+				t.Errorf("Triggers = %v, want %v", got.Triggers, tc.triggers)
+			}
+
+			var groups []string
+			for _, g := range got.Groups {
+				groups = append(groups, g.Group["service"])
+			}
+			if want := []string{"api", "web", "worker"}; !reflect.DeepEqual(groups, want) {
+				t.Errorf("Groups = %v, want %v", groups, want)
+			}
+			if api := got.Groups[0]; !reflect.DeepEqual(api.Triggers, at(0, 4)) || api.Breaches != 4 {
+				t.Errorf("api = %+v, want triggers at buckets 0 and 4 and 4 breaches", api)
+			}
+			if worker := got.Groups[2]; worker.NoData != 1 {
+				t.Errorf("worker = %+v, want 1 evaluation without data", worker)
+			}
+		})
+	}
+}
+
+func TestEvaluateAlignsPointsToBuckets(t *testing.T) {
+	// The range does not divide into steps: the first 5 minutes are dropped and
+	// points are matched to the bucket containing them.
+	s := Series{Points: []Point{
+		{Time: start.Add(-time.Minute), Value: 100},
+		{Time: start.Add(7 * time.Minute), Value: 100},
+		{Time: end, Value: 100},
+	}}
+	m := Monitor{Conditions: []Condition{above(10, s)}, AlertLogic: "any", NoDataBehavior: "resolve"}
+
+	got, err := Evaluate(m, start.Add(-5*time.Minute), end, step)
+	if err != nil {
+		t.Fatalf("Evaluate() returned error: %s", err)
+	}
+	if got.Evaluations != 6 || !reflect.DeepEqual(got.Triggers, at(0)) {
+		t.Errorf("Evaluate() = %+v, want 6 evaluations and a trigger at the end of the first bucket", got)
+	}
+}
+
+func TestEvaluateErrors(t *testing.T) {
+	valid := Monitor{Conditions: []Condition{above(1)}, AlertLogic: "any", NoDataBehavior: "alert"}
+
+	cases := []struct {
+		name   string
+		modify func(*Monitor)
+		end    time.Time
+		msg    string
+	}{
+		{"no conditions", func(m *Monitor) { m.Conditions = nil }, end, "no conditions"},
+		{"operator", func(m *Monitor) { m.Conditions[0].Operator = "above" }, end, `unknown operator "above"`},
+		{"alert logic", func(m *Monitor) { m.AlertLogic = "most" }, end, `unknown aggregation alert logic "most"`},
+		{"proportion", func(m *Monitor) { m.AlertLogic = "proportion" }, end, "between 1 and 99"},
+		{"no data", func(m *Monitor) { m.NoDataBehavior = "ignore" }, end, `unknown no-data behavior "ignore"`},
+		{"short range", func(*Monitor) {}, start.Add(time.Minute), "shorter than one evaluation step"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := valid
+			m.Conditions = append([]Condition(nil), valid.Conditions...)
+			tc.modify(&m)
+			_, err := Evaluate(m, start, tc.end, step)
+			if err == nil || !strings.Contains(err.Error(), tc.msg) {
+				t.Errorf("Evaluate() error = %v, want %q", err, tc.msg)
+			}
+		})
+	}
+}
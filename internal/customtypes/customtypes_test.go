@@ -0,0 +1,72 @@
+package customtypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestQuerySemanticEquals(t *testing.T) {
+	ctx := context.Background()
+	cases := []struct {
+		prior, next string
+		want        bool
+	}{
+		{"service:api AND env:prod", "service:api  AND\n  env:prod", true},
+		{"service:api AND env:prod", "service:api AND env:staging", false},
+		{`message:"a  b"`, `message:"a b"`, false},
+	}
+	for _, tc := range cases {
+		got, diags := NewQueryValue(tc.prior).StringSemanticEquals(ctx, NewQueryValue(tc.next))
+		if diags.HasError() {
+			t.Fatalf("StringSemanticEquals(%q, %q): %v", tc.prior, tc.next, diags)
+		}
+		if got != tc.want {
+			t.Errorf("StringSemanticEquals(%q, %q) = %v, want %v", tc.prior, tc.next, got, tc.want)
+		}
+	}
+}
+
+func TestFloat64SemanticEquals(t *testing.T) {
+	ctx := context.Background()
+	cases := []struct {
+		prior, next float64
+		want        bool
+	}{
+		{99.9, 99.89999999999999, true},
+		{0, 0, true},
+		{0.1 + 0.2, 0.3, true},
+		{99.9, 99.95, false},
+		{0, 1e-12, false},
+	}
+	for _, tc := range cases {
+		got, diags := NewFloat64Value(tc.prior).Float64SemanticEquals(ctx, NewFloat64Value(tc.next))
+		if diags.HasError() {
+			t.Fatalf("Float64SemanticEquals(%v, %v): %v", tc.prior, tc.next, diags)
+		}
+		if got != tc.want {
+			t.Errorf("Float64SemanticEquals(%v, %v) = %v, want %v", tc.prior, tc.next, got, tc.want)
+		}
+	}
+}
+
+func TestValueFromTerraform(t *testing.T) {
+	ctx := context.Background()
+
+	q, err := QueryType{}.ValueFromTerraform(ctx, tftypes.NewValue(tftypes.String, "env:prod"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !q.Equal(NewQueryValue("env:prod")) {
+		t.Errorf("QueryType.ValueFromTerraform() = %v", q)
+	}
+
+	f, err := Float64Type{}.ValueFromTerraform(ctx, tftypes.NewValue(tftypes.Number, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !f.Equal(NewFloat64Null()) {
+		t.Errorf("Float64Type.ValueFromTerraform() = %v", f)
+	}
+}
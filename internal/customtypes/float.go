@@ -0,0 +1,108 @@
+package customtypes
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.Float64Typable                    = Float64Type{}
+	_ basetypes.Float64ValuableWithSemanticEquals = Float64Value{}
+)
+
+// float64Tolerance is the relative difference below which two floats are equal. It
+// absorbs the rounding the API introduces when it stores or rescales a threshold,
+// e.g. 99.9 read back as 99.89999999999999, and nothing a user would write.
+const float64Tolerance = 1e-9
+
+// Float64Equal reports whether a and b are equal up to float64Tolerance.
+func Float64Equal(a, b float64) bool {
+	if a == b {
+		return true
+	}
+	return math.Abs(a-b) <= float64Tolerance*math.Max(math.Abs(a), math.Abs(b))
+}
+
+// Float64Type is the type of float attributes, such as thresholds, that the API
+// may round-trip with a different last digit. Values equal up to float64Tolerance
+// are semantically equal.
+type Float64Type struct {
+	basetypes.Float64Type
+}
+
+func (t Float64Type) Equal(o attr.Type) bool {
+	other, ok := o.(Float64Type)
+	if !ok {
+		return false
+	}
+	return t.Float64Type.Equal(other.Float64Type)
+}
+
+func (t Float64Type) String() string {
+	return "customtypes.Float64Type"
+}
+
+func (t Float64Type) ValueFromFloat64(_ context.Context, in basetypes.Float64Value) (basetypes.Float64Valuable, diag.Diagnostics) {
+	return Float64Value{Float64Value: in}, nil
+}
+
+func (t Float64Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.Float64Type.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	floatValue, ok := attrValue.(basetypes.Float64Value)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return Float64Value{Float64Value: floatValue}, nil
+}
+
+func (t Float64Type) ValueType(_ context.Context) attr.Value {
+	return Float64Value{}
+}
+
+// Float64Value is a value of Float64Type.
+type Float64Value struct {
+	basetypes.Float64Value
+}
+
+// NewFloat64Value returns a known float.
+func NewFloat64Value(v float64) Float64Value {
+	return Float64Value{Float64Value: basetypes.NewFloat64Value(v)}
+}
+
+// NewFloat64Null returns a null float.
+func NewFloat64Null() Float64Value {
+	return Float64Value{Float64Value: basetypes.NewFloat64Null()}
+}
+
+func (v Float64Value) Equal(o attr.Value) bool {
+	other, ok := o.(Float64Value)
+	if !ok {
+		return false
+	}
+	return v.Float64Value.Equal(other.Float64Value)
+}
+
+func (v Float64Value) Type(_ context.Context) attr.Type {
+	return Float64Type{}
+}
+
+// Float64SemanticEquals reports whether the floats are equal up to
+// float64Tolerance.
+func (v Float64Value) Float64SemanticEquals(_ context.Context, newValuable basetypes.Float64Valuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	newValue, ok := newValuable.(Float64Value)
+	if !ok {
+		diags.AddError("Semantic Equality Check Error", fmt.Sprintf("Expected value type %T, got %T.", v, newValuable))
+		return false, diags
+	}
+	return Float64Equal(v.ValueFloat64(), newValue.ValueFloat64()), diags
+}
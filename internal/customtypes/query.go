@@ -0,0 +1,93 @@
+// Package customtypes holds framework attribute types whose values compare by
+// meaning rather than by text, so that the API's normalization of a value does not
+// show up as a diff, or as an inconsistent result, after apply.
+package customtypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"terraform-provider-tsuga/internal/query"
+)
+
+var (
+	_ basetypes.StringTypable                    = QueryType{}
+	_ basetypes.StringValuableWithSemanticEquals = QueryValue{}
+)
+
+// QueryType is the type of attributes holding a query in the Tsuga search syntax.
+// Values differing only in the whitespace between tokens are semantically equal.
+type QueryType struct {
+	basetypes.StringType
+}
+
+func (t QueryType) Equal(o attr.Type) bool {
+	other, ok := o.(QueryType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t QueryType) String() string {
+	return "customtypes.QueryType"
+}
+
+func (t QueryType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return QueryValue{StringValue: in}, nil
+}
+
+func (t QueryType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return QueryValue{StringValue: stringValue}, nil
+}
+
+func (t QueryType) ValueType(_ context.Context) attr.Value {
+	return QueryValue{}
+}
+
+// QueryValue is a value of QueryType.
+type QueryValue struct {
+	basetypes.StringValue
+}
+
+// NewQueryValue returns a known query.
+func NewQueryValue(v string) QueryValue {
+	return QueryValue{StringValue: basetypes.NewStringValue(v)}
+}
+
+func (v QueryValue) Equal(o attr.Value) bool {
+	other, ok := o.(QueryValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v QueryValue) Type(_ context.Context) attr.Type {
+	return QueryType{}
+}
+
+// StringSemanticEquals reports whether the queries are equivalent, see
+// query.Equivalent.
+func (v QueryValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	newValue, ok := newValuable.(QueryValue)
+	if !ok {
+		diags.AddError("Semantic Equality Check Error", fmt.Sprintf("Expected value type %T, got %T.", v, newValuable))
+		return false, diags
+	}
+	return query.Equivalent(v.ValueString(), newValue.ValueString()), diags
+}
@@ -0,0 +1,71 @@
+package datasource_cloud_accounts
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func CloudAccountsDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Lists the cloud accounts connected to Tsuga for inventory scanning, whether managed by `tsuga_cloud_account` or connected in the Tsuga UI.",
+		Attributes: map[string]schema.Attribute{
+			"cloud_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list accounts of this cloud provider: `aws`, `gcp` or `azure`",
+				Validators: []validator.String{
+					stringvalidator.OneOf("aws", "gcp", "azure"),
+				},
+			},
+			"cloud_accounts": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The cloud accounts, in the order returned by the API",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Tsuga ID of the cloud account, as used to import `tsuga_cloud_account`",
+						},
+						"cloud_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Cloud provider of the account: `aws`, `gcp` or `azure`",
+						},
+						"cloud_account_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Cloud-native account identifier (AWS account ID or GCP project ID)",
+						},
+						"account_friendly_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Human-readable name of the account, when one is set",
+						},
+					},
+				},
+			},
+			"ids": schema.ListAttribute{
+				Computed:    true,
+				Description: "Tsuga IDs of the cloud accounts, in the same order as `cloud_accounts`",
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+type CloudAccountsModel struct {
+	CloudType     types.String `tfsdk:"cloud_type"`
+	CloudAccounts types.List   `tfsdk:"cloud_accounts"`
+	Ids           types.List   `tfsdk:"ids"`
+}
+
+// CloudAccountAttrTypes returns the attribute types of a cloud_accounts element.
+func CloudAccountAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                    types.StringType,
+		"cloud_type":            types.StringType,
+		"cloud_account_id":      types.StringType,
+		"account_friendly_name": types.StringType,
+	}
+}
@@ -0,0 +1,71 @@
+package datasource_clusters
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func ClustersDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Lists the Tsuga clusters available to the organization. Their IDs scope monitors and SLOs through `cluster_ids`, and telemetry requests through the provider's `cluster_id`.",
+		Attributes: map[string]schema.Attribute{
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list clusters in this cloud region, such as `us-east-1`",
+			},
+			"clusters": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The clusters, in the order returned by the API",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Cluster ID, as used in `cluster_ids` and the provider's `cluster_id`",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Cluster name",
+						},
+						"friendly_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Human-readable display name, when one is configured",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Cloud provider hosting the cluster: `aws`, `gcp` or `azure`",
+						},
+						"region": schema.StringAttribute{
+							Computed:    true,
+							Description: "Cloud region of the cluster",
+						},
+					},
+				},
+			},
+			"ids": schema.ListAttribute{
+				Computed:    true,
+				Description: "IDs of the clusters, in the same order as `clusters`",
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+type ClustersModel struct {
+	Region   types.String `tfsdk:"region"`
+	Clusters types.List   `tfsdk:"clusters"`
+	Ids      types.List   `tfsdk:"ids"`
+}
+
+// ClusterAttrTypes returns the attribute types of a clusters element.
+func ClusterAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":            types.StringType,
+		"name":          types.StringType,
+		"friendly_name": types.StringType,
+		"type":          types.StringType,
+		"region":        types.StringType,
+	}
+}
@@ -0,0 +1,73 @@
+package datasource_grok_parse
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func GrokParseDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Parses sample log lines with Grok rules locally, using the same pattern library and `%{PATTERN:name:filter}` syntax as the log route Grok parser. No API call is made, so rules can be tested offline or in `terraform console`.",
+		Attributes: map[string]schema.Attribute{
+			"rules": schema.ListAttribute{
+				Required:    true,
+				Description: "Ordered Grok rules. For each sample, the rules are tried in order and the first match wins, like the route Grok processor.",
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 25),
+					listvalidator.ValueStringsAre(stringvalidator.LengthBetween(1, 50000)),
+				},
+			},
+			"samples": schema.ListAttribute{
+				Required:    true,
+				Description: "Sample log lines to parse with the rules",
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 100),
+					listvalidator.ValueStringsAre(stringvalidator.LengthBetween(1, 50000)),
+				},
+			},
+			"results": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Parse result for each sample, in the same order as `samples`",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"sample": schema.StringAttribute{
+							Computed:    true,
+							Description: "The sample log line",
+						},
+						"matched": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether any rule matched the sample",
+						},
+						"extracted": schema.StringAttribute{
+							Computed:    true,
+							Description: "JSON-encoded fields extracted by the first matching rule, with dotted capture names expanded into nested objects. Null when no rule matched; use `jsondecode` to read it.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type GrokParseModel struct {
+	Rules   types.List `tfsdk:"rules"`
+	Samples types.List `tfsdk:"samples"`
+	Results types.List `tfsdk:"results"`
+}
+
+// ResultAttrTypes returns the attribute types of a results element.
+func ResultAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"sample":    types.StringType,
+		"matched":   types.BoolType,
+		"extracted": types.StringType,
+	}
+}
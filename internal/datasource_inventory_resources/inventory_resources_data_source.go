@@ -0,0 +1,189 @@
+package datasource_inventory_resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NativeResourceTypes are the cloud resource types inventory scans discover.
+var NativeResourceTypes = []string{
+	"azure_web_app", "gcp_app_engine_service", "aws_s3_bucket", "gcp_bucket",
+	"azure_blob_storage_container", "aws_kinesis_data_stream", "aws_sqs_queue",
+	"aws_sns_topic", "azure_service_bus_namespace", "azure_service_bus_queue",
+	"gcp_pubsub_topic", "azure_event_grid_topic", "azure_event_hub_namespace",
+	"azure_event_hub", "aws_efs", "azure_file_share", "aws_cloudfront_distribution",
+	"gcp_cloud_cdn_configuration", "aws_dynamodb_table", "aws_elasticache",
+	"aws_rds_instance", "azure_database_for_mysql_server",
+	"azure_database_for_postgres_server", "azure_sql_database_server", "azure_sql_database",
+	"gcp_bigtable_instance", "gcp_cloud_sql_instance", "gcp_firestore_database",
+	"gcp_spanner_instance", "azure_cosmos_db_account", "azure_storage_account",
+	"aws_ebs_volume", "gcp_compute_disk", "azure_disk", "aws_ec2_instance",
+	"gcp_compute_instance", "azure_compute_virtual_machine", "aws_lambda_function",
+	"gcp_cloud_run_function", "gcp_cloud_run_job", "gcp_cloud_run_service",
+	"azure_function_app", "aws_eks_cluster", "gcp_gke_cluster", "azure_aks_cluster",
+	"aws_ecr_repository", "gcp_artifact_registry_repository", "azure_container_registry",
+	"aws_ecs_cluster", "aws_ecs_task", "aws_ecs_service", "azure_container_instance_group",
+	"aws_ecr_image", "gcp_artifact_registry_docker_image", "azure_container_registry_image",
+	"aws_vpc", "gcp_vpc", "azure_virtual_network", "aws_elastic_load_balancer",
+	"gcp_forwarding_rule", "azure_load_balancer", "azure_traffic_manager_profile",
+	"aws_target_group", "aws_acm_certificate", "gcp_certificate_manager_certificate",
+	"azure_app_service_certificate",
+}
+
+func InventoryResourcesDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Lists the cloud resources discovered by inventory scans of the connected cloud accounts. Every filter set must match; all pages are read.",
+		Attributes: map[string]schema.Attribute{
+			"cloud_platforms": schema.ListAttribute{
+				Optional:    true,
+				Description: "Only list resources on these cloud platforms: `aws`, `gcp` or `azure`",
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.OneOf("aws", "gcp", "azure")),
+				},
+			},
+			"cloud_accounts": schema.ListAttribute{
+				Optional:    true,
+				Description: "Only list resources in these cloud accounts, such as AWS account IDs or GCP project IDs",
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 500),
+					listvalidator.ValueStringsAre(stringvalidator.LengthBetween(1, 250)),
+				},
+			},
+			"native_resource_types": schema.ListAttribute{
+				Optional:    true,
+				Description: "Only list resources of these cloud resource types, such as `aws_s3_bucket` or `gcp_compute_instance`",
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(NativeResourceTypes...)),
+				},
+			},
+			"search": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list resources whose name matches this search text",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 250),
+				},
+			},
+			"resources": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching resources, in the order returned by the API",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Tsuga ID of the resource",
+						},
+						"cloud_platform": schema.StringAttribute{
+							Computed:    true,
+							Description: "Cloud platform: `aws`, `gcp` or `azure`",
+						},
+						"cloud_account": schema.StringAttribute{
+							Computed:    true,
+							Description: "Cloud account the resource belongs to",
+						},
+						"cloud_region": schema.StringAttribute{
+							Computed:    true,
+							Description: "Cloud region of the resource, when it has one",
+						},
+						"fully_qualified_resource_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Cloud provider identifier of the resource, such as an ARN",
+						},
+						"display_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Display name of the resource",
+						},
+						"native_resource_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Cloud resource type, such as `aws_s3_bucket`",
+						},
+						"category": schema.StringAttribute{
+							Computed:    true,
+							Description: "Resource category, such as `compute` or `data`",
+						},
+						"resource_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Cloud-agnostic resource type, such as `virtualMachine` or `bucket`",
+						},
+						"resource_created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "When the resource was created, as reported by the cloud provider",
+						},
+						"resource_updated_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "When the resource was last updated, as reported by the cloud provider",
+						},
+						"resource_last_seen_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "When an inventory scan last saw the resource",
+						},
+						"tags": schema.MapAttribute{
+							Computed:    true,
+							Description: "Cloud tags of the resource",
+							ElementType: types.StringType,
+						},
+						"attributes": schema.StringAttribute{
+							Computed:    true,
+							Description: "JSON-encoded attributes specific to `resource_type`, such as `instanceType` for virtual machines. Use `jsondecode` to read it.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type InventoryResourcesModel struct {
+	CloudPlatforms      types.List   `tfsdk:"cloud_platforms"`
+	CloudAccounts       types.List   `tfsdk:"cloud_accounts"`
+	NativeResourceTypes types.List   `tfsdk:"native_resource_types"`
+	Search              types.String `tfsdk:"search"`
+	Resources           types.List   `tfsdk:"resources"`
+}
+
+type InventoryResourceModel struct {
+	Id                       types.String `tfsdk:"id"`
+	CloudPlatform            types.String `tfsdk:"cloud_platform"`
+	CloudAccount             types.String `tfsdk:"cloud_account"`
+	CloudRegion              types.String `tfsdk:"cloud_region"`
+	FullyQualifiedResourceId types.String `tfsdk:"fully_qualified_resource_id"`
+	DisplayName              types.String `tfsdk:"display_name"`
+	NativeResourceType       types.String `tfsdk:"native_resource_type"`
+	Category                 types.String `tfsdk:"category"`
+	ResourceType             types.String `tfsdk:"resource_type"`
+	ResourceCreatedAt        types.String `tfsdk:"resource_created_at"`
+	ResourceUpdatedAt        types.String `tfsdk:"resource_updated_at"`
+	ResourceLastSeenAt       types.String `tfsdk:"resource_last_seen_at"`
+	Tags                     types.Map    `tfsdk:"tags"`
+	Attributes               types.String `tfsdk:"attributes"`
+}
+
+// InventoryResourceAttrTypes returns the attribute types of a resources element.
+func InventoryResourceAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                          types.StringType,
+		"cloud_platform":              types.StringType,
+		"cloud_account":               types.StringType,
+		"cloud_region":                types.StringType,
+		"fully_qualified_resource_id": types.StringType,
+		"display_name":                types.StringType,
+		"native_resource_type":        types.StringType,
+		"category":                    types.StringType,
+		"resource_type":               types.StringType,
+		"resource_created_at":         types.StringType,
+		"resource_updated_at":         types.StringType,
+		"resource_last_seen_at":       types.StringType,
+		"tags":                        types.MapType{ElemType: types.StringType},
+		"attributes":                  types.StringType,
+	}
+}
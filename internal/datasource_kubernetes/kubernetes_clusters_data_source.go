@@ -0,0 +1,132 @@
+package datasource_kubernetes
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func KubernetesClustersDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Lists the Kubernetes clusters observed in the telemetry of the organization, with their resource usage, ready nodes and pod phase counts.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": clusterIDAttribute(),
+			// Kubernetes cluster names are not RFC 1123 names, so the API accepts
+			// uppercase letters and underscores in their search.
+			"search": searchAttribute("Only list Kubernetes clusters whose name contains this string, such as `prod`", `^[A-Za-z0-9._-]+$`),
+			"clusters": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The Kubernetes clusters, in the order returned by the API",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the Kubernetes cluster",
+						},
+						"teams": schema.ListAttribute{
+							Computed:    true,
+							Description: "Teams owning telemetry of the cluster",
+							ElementType: types.StringType,
+						},
+						"envs": schema.ListAttribute{
+							Computed:    true,
+							Description: "Environments reported by the cluster",
+							ElementType: types.StringType,
+						},
+						"ready_nodes": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of ready nodes, or null when it is not reported",
+						},
+						"pod_phase_counts": schema.MapAttribute{
+							Computed:    true,
+							Description: "Number of pods in each phase: `Pending`, `Running`, `Succeeded`, `Failed` and `Unknown`, or null when they are not reported",
+							ElementType: types.Int64Type,
+						},
+						"cpu_usage_milli":    cpuUsageAttribute(),
+						"memory_usage_bytes": memoryUsageAttribute(),
+					},
+				},
+			},
+			"names": schema.ListAttribute{
+				Computed:    true,
+				Description: "Names of the Kubernetes clusters, in the same order as `clusters`",
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+type KubernetesClustersModel struct {
+	ClusterId types.String `tfsdk:"cluster_id"`
+	Search    types.String `tfsdk:"search"`
+	Clusters  types.List   `tfsdk:"clusters"`
+	Names     types.List   `tfsdk:"names"`
+}
+
+type KubernetesClusterModel struct {
+	Name             types.String  `tfsdk:"name"`
+	Teams            types.List    `tfsdk:"teams"`
+	Envs             types.List    `tfsdk:"envs"`
+	ReadyNodes       types.Int64   `tfsdk:"ready_nodes"`
+	PodPhaseCounts   types.Map     `tfsdk:"pod_phase_counts"`
+	CpuUsageMilli    types.Float64 `tfsdk:"cpu_usage_milli"`
+	MemoryUsageBytes types.Float64 `tfsdk:"memory_usage_bytes"`
+}
+
+// KubernetesClusterAttrTypes returns the attribute types of a clusters element.
+func KubernetesClusterAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":               types.StringType,
+		"teams":              types.ListType{ElemType: types.StringType},
+		"envs":               types.ListType{ElemType: types.StringType},
+		"ready_nodes":        types.Int64Type,
+		"pod_phase_counts":   types.MapType{ElemType: types.Int64Type},
+		"cpu_usage_milli":    types.Float64Type,
+		"memory_usage_bytes": types.Float64Type,
+	}
+}
+
+func clusterIDAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Description: "Tsuga cluster to read, as listed by `tsuga_clusters`. Defaults to the provider's `cluster_id`, or the API's default cluster",
+	}
+}
+
+func searchAttribute(description, pattern string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Description: description,
+		Validators: []validator.String{
+			stringvalidator.LengthBetween(1, 256),
+			stringvalidator.RegexMatches(regexp.MustCompile(pattern), "must only contain the characters of Kubernetes names"),
+		},
+	}
+}
+
+// kubernetesClusterAttribute filters the resources of one Kubernetes cluster.
+func kubernetesClusterAttribute(resources string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Description: "Only list " + resources + " of the Kubernetes cluster with this name, as listed by `tsuga_kubernetes_clusters`",
+	}
+}
+
+func cpuUsageAttribute() schema.Float64Attribute {
+	return schema.Float64Attribute{
+		Computed:    true,
+		Description: "CPU usage in millicores, or null when it is not reported",
+	}
+}
+
+func memoryUsageAttribute() schema.Float64Attribute {
+	return schema.Float64Attribute{
+		Computed:    true,
+		Description: "Memory usage in bytes, or null when it is not reported",
+	}
+}
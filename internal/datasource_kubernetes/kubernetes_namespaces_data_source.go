@@ -0,0 +1,92 @@
+package datasource_kubernetes
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// resourceNamePattern matches the search of Kubernetes resources, whose names are
+// lowercase RFC 1123 names.
+const resourceNamePattern = `^[a-z0-9.-]+$`
+
+func KubernetesNamespacesDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Lists the Kubernetes namespaces observed in the telemetry of the organization, with their pod count and resource usage, for generating monitors and dashboard filters per namespace. Every filter set must match.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id":         clusterIDAttribute(),
+			"search":             searchAttribute("Only list namespaces whose name contains this string, such as `payments`", resourceNamePattern),
+			"kubernetes_cluster": kubernetesClusterAttribute("namespaces"),
+			"namespaces": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The namespaces, in the order returned by the API. A namespace present in several Kubernetes clusters is listed once per cluster",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the namespace",
+						},
+						"kubernetes_cluster": schema.StringAttribute{
+							Computed:    true,
+							Description: "Kubernetes cluster of the namespace",
+						},
+						"teams": schema.ListAttribute{
+							Computed:    true,
+							Description: "Teams owning telemetry of the namespace",
+							ElementType: types.StringType,
+						},
+						"envs": schema.ListAttribute{
+							Computed:    true,
+							Description: "Environments reported by the namespace",
+							ElementType: types.StringType,
+						},
+						"pods": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of pods in the namespace, or null when it is not reported",
+						},
+						"cpu_usage_milli":    cpuUsageAttribute(),
+						"memory_usage_bytes": memoryUsageAttribute(),
+					},
+				},
+			},
+			"names": schema.ListAttribute{
+				Computed:    true,
+				Description: "Distinct names of the namespaces, sorted, for use in `for_each`",
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+type KubernetesNamespacesModel struct {
+	ClusterId         types.String `tfsdk:"cluster_id"`
+	Search            types.String `tfsdk:"search"`
+	KubernetesCluster types.String `tfsdk:"kubernetes_cluster"`
+	Namespaces        types.List   `tfsdk:"namespaces"`
+	Names             types.List   `tfsdk:"names"`
+}
+
+type KubernetesNamespaceModel struct {
+	Name              types.String  `tfsdk:"name"`
+	KubernetesCluster types.String  `tfsdk:"kubernetes_cluster"`
+	Teams             types.List    `tfsdk:"teams"`
+	Envs              types.List    `tfsdk:"envs"`
+	Pods              types.Int64   `tfsdk:"pods"`
+	CpuUsageMilli     types.Float64 `tfsdk:"cpu_usage_milli"`
+	MemoryUsageBytes  types.Float64 `tfsdk:"memory_usage_bytes"`
+}
+
+// KubernetesNamespaceAttrTypes returns the attribute types of a namespaces element.
+func KubernetesNamespaceAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":               types.StringType,
+		"kubernetes_cluster": types.StringType,
+		"teams":              types.ListType{ElemType: types.StringType},
+		"envs":               types.ListType{ElemType: types.StringType},
+		"pods":               types.Int64Type,
+		"cpu_usage_milli":    types.Float64Type,
+		"memory_usage_bytes": types.Float64Type,
+	}
+}
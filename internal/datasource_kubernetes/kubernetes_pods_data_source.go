@@ -0,0 +1,157 @@
+package datasource_kubernetes
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func KubernetesPodsDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Lists the Kubernetes pods observed in the telemetry of the organization, with their phase, owning workload and resource usage. Every filter set must match.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id":         clusterIDAttribute(),
+			"search":             searchAttribute("Only list pods whose name contains this string, such as `checkout`", resourceNamePattern),
+			"kubernetes_cluster": kubernetesClusterAttribute("pods"),
+			"namespace": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list pods of this namespace",
+			},
+			"pods": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The pods, in the order returned by the API",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the pod",
+						},
+						"namespace": schema.StringAttribute{
+							Computed:    true,
+							Description: "Namespace of the pod",
+						},
+						"kubernetes_cluster": schema.StringAttribute{
+							Computed:    true,
+							Description: "Kubernetes cluster of the pod",
+						},
+						"team": schema.StringAttribute{
+							Computed:    true,
+							Description: "Team owning the pod, or null",
+						},
+						"env": schema.StringAttribute{
+							Computed:    true,
+							Description: "Environment of the pod, or null",
+						},
+						"node": schema.StringAttribute{
+							Computed:    true,
+							Description: "Node running the pod, or null",
+						},
+						"phase": schema.StringAttribute{
+							Computed:    true,
+							Description: "Phase of the pod: `Pending`, `Running`, `Succeeded`, `Failed`, `Unknown`, `CrashLoopBackOff` or `Error`",
+						},
+						"ready_containers": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of ready containers",
+						},
+						"total_containers": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of containers",
+						},
+						"restarts": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of container restarts",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Creation time of the pod, or null",
+						},
+						"workload_kind": schema.StringAttribute{
+							Computed:    true,
+							Description: "Kind of the workload owning the pod: `deployment`, `statefulset` or `daemonset`, or null for a standalone pod",
+						},
+						"workload_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the workload owning the pod, or null for a standalone pod",
+						},
+						"cpu_usage_milli": cpuUsageAttribute(),
+						"cpu_limit_milli": schema.Float64Attribute{
+							Computed:    true,
+							Description: "CPU limit in millicores, or null when the pod has none",
+						},
+						"cpu_utilization": schema.Float64Attribute{
+							Computed:    true,
+							Description: "CPU usage as a fraction of the limit, or null when the pod has no limit",
+						},
+						"memory_usage_bytes": memoryUsageAttribute(),
+						"memory_limit_bytes": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Memory limit in bytes, or null when the pod has none",
+						},
+						"memory_utilization": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Memory usage as a fraction of the limit, or null when the pod has no limit",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type KubernetesPodsModel struct {
+	ClusterId         types.String `tfsdk:"cluster_id"`
+	Search            types.String `tfsdk:"search"`
+	KubernetesCluster types.String `tfsdk:"kubernetes_cluster"`
+	Namespace         types.String `tfsdk:"namespace"`
+	Pods              types.List   `tfsdk:"pods"`
+}
+
+type KubernetesPodModel struct {
+	Name              types.String  `tfsdk:"name"`
+	Namespace         types.String  `tfsdk:"namespace"`
+	KubernetesCluster types.String  `tfsdk:"kubernetes_cluster"`
+	Team              types.String  `tfsdk:"team"`
+	Env               types.String  `tfsdk:"env"`
+	Node              types.String  `tfsdk:"node"`
+	Phase             types.String  `tfsdk:"phase"`
+	ReadyContainers   types.Int64   `tfsdk:"ready_containers"`
+	TotalContainers   types.Int64   `tfsdk:"total_containers"`
+	Restarts          types.Int64   `tfsdk:"restarts"`
+	CreatedAt         types.String  `tfsdk:"created_at"`
+	WorkloadKind      types.String  `tfsdk:"workload_kind"`
+	WorkloadName      types.String  `tfsdk:"workload_name"`
+	CpuUsageMilli     types.Float64 `tfsdk:"cpu_usage_milli"`
+	CpuLimitMilli     types.Float64 `tfsdk:"cpu_limit_milli"`
+	CpuUtilization    types.Float64 `tfsdk:"cpu_utilization"`
+	MemoryUsageBytes  types.Float64 `tfsdk:"memory_usage_bytes"`
+	MemoryLimitBytes  types.Float64 `tfsdk:"memory_limit_bytes"`
+	MemoryUtilization types.Float64 `tfsdk:"memory_utilization"`
+}
+
+// KubernetesPodAttrTypes returns the attribute types of a pods element.
+func KubernetesPodAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":               types.StringType,
+		"namespace":          types.StringType,
+		"kubernetes_cluster": types.StringType,
+		"team":               types.StringType,
+		"env":                types.StringType,
+		"node":               types.StringType,
+		"phase":              types.StringType,
+		"ready_containers":   types.Int64Type,
+		"total_containers":   types.Int64Type,
+		"restarts":           types.Int64Type,
+		"created_at":         types.StringType,
+		"workload_kind":      types.StringType,
+		"workload_name":      types.StringType,
+		"cpu_usage_milli":    types.Float64Type,
+		"cpu_limit_milli":    types.Float64Type,
+		"cpu_utilization":    types.Float64Type,
+		"memory_usage_bytes": types.Float64Type,
+		"memory_limit_bytes": types.Float64Type,
+		"memory_utilization": types.Float64Type,
+	}
+}
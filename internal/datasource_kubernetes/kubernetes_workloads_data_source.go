@@ -0,0 +1,126 @@
+package datasource_kubernetes
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// WorkloadKinds are the kinds of workloads the Kubernetes explorer lists.
+var WorkloadKinds = []string{"deployment", "statefulset", "daemonset"}
+
+func KubernetesWorkloadsDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Lists the Kubernetes deployments, StatefulSets and DaemonSets observed in the telemetry of the organization, with their replicas and resource usage, for generating monitors per workload. Every filter set must match.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": clusterIDAttribute(),
+			"search":     searchAttribute("Only list workloads whose name contains this string, such as `api`", resourceNamePattern),
+			"kinds": schema.ListAttribute{
+				Optional:    true,
+				Description: "Kinds of workloads to list: `deployment`, `statefulset` and `daemonset`. Defaults to all of them",
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(WorkloadKinds...)),
+				},
+			},
+			"kubernetes_cluster": kubernetesClusterAttribute("workloads"),
+			"namespace": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list workloads of this namespace",
+			},
+			"workloads": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The workloads, by kind in the order of `kinds`, then in the order returned by the API",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"kind": schema.StringAttribute{
+							Computed:    true,
+							Description: "Kind of the workload: `deployment`, `statefulset` or `daemonset`",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the workload",
+						},
+						"namespace": schema.StringAttribute{
+							Computed:    true,
+							Description: "Namespace of the workload",
+						},
+						"kubernetes_cluster": schema.StringAttribute{
+							Computed:    true,
+							Description: "Kubernetes cluster of the workload",
+						},
+						"team": schema.StringAttribute{
+							Computed:    true,
+							Description: "Team owning the workload, or null",
+						},
+						"env": schema.StringAttribute{
+							Computed:    true,
+							Description: "Environment of the workload, or null",
+						},
+						"pods": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of pods of the workload, or null when it is not reported",
+						},
+						"ready_replicas": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of available replicas of a deployment, ready replicas of a StatefulSet, or ready nodes of a DaemonSet, or null when it is not reported",
+						},
+						"desired_replicas": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of desired replicas of a deployment or StatefulSet, or of nodes eligible to a DaemonSet, or null when it is not reported",
+						},
+						"cpu_usage_milli":    cpuUsageAttribute(),
+						"memory_usage_bytes": memoryUsageAttribute(),
+					},
+				},
+			},
+		},
+	}
+}
+
+type KubernetesWorkloadsModel struct {
+	ClusterId         types.String `tfsdk:"cluster_id"`
+	Search            types.String `tfsdk:"search"`
+	Kinds             types.List   `tfsdk:"kinds"`
+	KubernetesCluster types.String `tfsdk:"kubernetes_cluster"`
+	Namespace         types.String `tfsdk:"namespace"`
+	Workloads         types.List   `tfsdk:"workloads"`
+}
+
+type KubernetesWorkloadModel struct {
+	Kind              types.String  `tfsdk:"kind"`
+	Name              types.String  `tfsdk:"name"`
+	Namespace         types.String  `tfsdk:"namespace"`
+	KubernetesCluster types.String  `tfsdk:"kubernetes_cluster"`
+	Team              types.String  `tfsdk:"team"`
+	Env               types.String  `tfsdk:"env"`
+	Pods              types.Int64   `tfsdk:"pods"`
+	ReadyReplicas     types.Int64   `tfsdk:"ready_replicas"`
+	DesiredReplicas   types.Int64   `tfsdk:"desired_replicas"`
+	CpuUsageMilli     types.Float64 `tfsdk:"cpu_usage_milli"`
+	MemoryUsageBytes  types.Float64 `tfsdk:"memory_usage_bytes"`
+}
+
+// KubernetesWorkloadAttrTypes returns the attribute types of a workloads element.
+func KubernetesWorkloadAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"kind":               types.StringType,
+		"name":               types.StringType,
+		"namespace":          types.StringType,
+		"kubernetes_cluster": types.StringType,
+		"team":               types.StringType,
+		"env":                types.StringType,
+		"pods":               types.Int64Type,
+		"ready_replicas":     types.Int64Type,
+		"desired_replicas":   types.Int64Type,
+		"cpu_usage_milli":    types.Float64Type,
+		"memory_usage_bytes": types.Float64Type,
+	}
+}
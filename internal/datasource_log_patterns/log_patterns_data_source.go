@@ -0,0 +1,166 @@
+package datasource_log_patterns
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Types are the kinds of patterns the data source can list.
+var Types = []string{"all", "new", "increase"}
+
+func LogPatternsDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Lists the log patterns of a time range: the patterns clustered from the logs matching a query, the error patterns first seen in the range, or the error patterns whose occurrence increased, for choosing the services that deserve a `log_error_pattern` monitor. The patterns are read on every plan, so they follow the data unless `to` is set.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Cluster to query, as listed by `tsuga_clusters`. Defaults to the provider's `cluster_id`, or the API's default cluster",
+			},
+			"window": schema.StringAttribute{
+				Required:    true,
+				Description: "Length of the time range, ending at `to`, such as `1h` or `7d`",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"to": schema.StringAttribute{
+				Optional:    true,
+				Description: "End of the time range, as an RFC 3339 timestamp such as `2024-06-01T00:00:00Z`. Defaults to the time of the read",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Patterns to list: `all` clusters the logs matching `query`, `new` lists the error patterns first seen in the time range and `increase` lists the error patterns of `team` whose occurrence increased. Defaults to `all`",
+				Validators: []validator.String{
+					stringvalidator.OneOf(Types...),
+				},
+			},
+			"query": schema.StringAttribute{
+				Optional:    true,
+				Description: "Tsuga query selecting the logs to cluster, such as `level:ERROR`. Required with the `all` type and not supported by the others",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 10000),
+				},
+			},
+			"team": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the team whose error patterns are listed. Required with the `increase` type and not supported with `all`",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 250),
+				},
+			},
+			"env": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the error patterns of this environment, such as `production`. Not supported with `all`",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 250),
+				},
+			},
+			"service": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the error patterns of this service. Only supported with the `new` type",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 250),
+				},
+			},
+			"patterns": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The patterns, in the order returned by the API",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"pattern": schema.StringAttribute{
+							Computed:    true,
+							Description: "Pattern formatted as a readable string. With the `new` type, the message of an example log of the pattern",
+						},
+						"count": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of logs matching the pattern with the `all` type, number of detected increases with the `increase` type, or null with the `new` type",
+						},
+						"service": schema.StringAttribute{
+							Computed:    true,
+							Description: "Service of the pattern, or null when the API does not report it or its logs come from several services",
+						},
+						"team": schema.StringAttribute{
+							Computed:    true,
+							Description: "Team of the pattern, or null when the API does not report it or its logs come from several teams",
+						},
+						"env": schema.StringAttribute{
+							Computed:    true,
+							Description: "Environment of the pattern, or null when the API does not report it or its logs come from several environments",
+						},
+						"groups": schema.MapAttribute{
+							Computed:    true,
+							Description: "Attributes shared by every log of the pattern, such as `level` or `context.team`, by attribute path. Only reported with the `all` type",
+							ElementType: types.StringType,
+						},
+						"first_seen_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Time the pattern was first seen, as an RFC 3339 timestamp. Only reported with the `new` type",
+						},
+						"last_seen_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Time the pattern was last seen, as an RFC 3339 timestamp. Only reported with the `new` type",
+						},
+						"increased_at": schema.ListAttribute{
+							Computed:    true,
+							Description: "Times of the detected increases, as RFC 3339 timestamps. Only reported with the `increase` type",
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+			"services": schema.ListAttribute{
+				Computed:    true,
+				Description: "Distinct services of the patterns, sorted, for use in `for_each`",
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+type LogPatternsModel struct {
+	ClusterId types.String `tfsdk:"cluster_id"`
+	Window    types.String `tfsdk:"window"`
+	To        types.String `tfsdk:"to"`
+	Type      types.String `tfsdk:"type"`
+	Query     types.String `tfsdk:"query"`
+	Team      types.String `tfsdk:"team"`
+	Env       types.String `tfsdk:"env"`
+	Service   types.String `tfsdk:"service"`
+	Patterns  types.List   `tfsdk:"patterns"`
+	Services  types.List   `tfsdk:"services"`
+}
+
+type PatternModel struct {
+	Pattern     types.String `tfsdk:"pattern"`
+	Count       types.Int64  `tfsdk:"count"`
+	Service     types.String `tfsdk:"service"`
+	Team        types.String `tfsdk:"team"`
+	Env         types.String `tfsdk:"env"`
+	Groups      types.Map    `tfsdk:"groups"`
+	FirstSeenAt types.String `tfsdk:"first_seen_at"`
+	LastSeenAt  types.String `tfsdk:"last_seen_at"`
+	IncreasedAt types.List   `tfsdk:"increased_at"`
+}
+
+// PatternAttrTypes returns the attribute types of a patterns element.
+func PatternAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"pattern":       types.StringType,
+		"count":         types.Int64Type,
+		"service":       types.StringType,
+		"team":          types.StringType,
+		"env":           types.StringType,
+		"groups":        types.MapType{ElemType: types.StringType},
+		"first_seen_at": types.StringType,
+		"last_seen_at":  types.StringType,
+		"increased_at":  types.ListType{ElemType: types.StringType},
+	}
+}
@@ -0,0 +1,34 @@
+package datasource_metrics
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func MetricDataSourceSchema(ctx context.Context) schema.Schema {
+	attributes := metricAttributes()
+	attributes["name"] = schema.StringAttribute{
+		Required:    true,
+		Description: "Name of the metric, as listed by `tsuga_metrics`",
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+	attributes["cluster_id"] = clusterIDAttribute()
+	attributes["window"] = windowAttribute()
+
+	return schema.Schema{
+		Description: "Reads the instrument type, unit and attributes of one metric observed in a cluster. The read fails when the metric was not observed in the window.",
+		Attributes:  attributes,
+	}
+}
+
+type MetricModel struct {
+	MetricItemModel
+	ClusterId types.String `tfsdk:"cluster_id"`
+	Window    types.String `tfsdk:"window"`
+}
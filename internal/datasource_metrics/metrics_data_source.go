@@ -0,0 +1,128 @@
+package datasource_metrics
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// MetricTypes are the instrument types the API reports for a metric.
+var MetricTypes = []string{"counter", "gauge", "histogram", "summary", "composite_histogram"}
+
+func MetricsDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Lists the metrics observed in a cluster over a time window, with their instrument type, unit and attributes, for checking metric names before using them in monitors and dashboards. Every filter set must match.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": clusterIDAttribute(),
+			"window":     windowAttribute(),
+			"name_prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list metrics whose name starts with this prefix, such as `k8s.node.`",
+			},
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list metrics of this instrument type: `counter`, `gauge`, `histogram`, `summary` or `composite_histogram`",
+				Validators: []validator.String{
+					stringvalidator.OneOf(MetricTypes...),
+				},
+			},
+			"metrics": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching metrics, in the order returned by the API",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: metricAttributes(),
+				},
+			},
+			"names": schema.ListAttribute{
+				Computed:    true,
+				Description: "Names of the matching metrics, in the same order as `metrics`",
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func clusterIDAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Description: "Cluster to read, as listed by `tsuga_clusters`. Defaults to the provider's `cluster_id`, or the API's default cluster",
+	}
+}
+
+func windowAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Description: "Time window before the read in which metrics must have been observed, such as `1h` or `7d`. Defaults to `1d`",
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+}
+
+// metricAttributes returns the computed attributes describing a metric.
+func metricAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: "Metric name, as used in the `field` of metrics query aggregates",
+		},
+		"type": schema.StringAttribute{
+			Computed:    true,
+			Description: "Instrument type: `counter`, `gauge`, `histogram`, `summary` or `composite_histogram`",
+		},
+		"unit": schema.StringAttribute{
+			Computed:    true,
+			Description: "Unit of the values, such as `By` or `ms`, when one is reported",
+		},
+		"temporality": schema.StringAttribute{
+			Computed:    true,
+			Description: "Aggregation temporality: `delta`, `cumulative` or `none`, when one is reported",
+		},
+		"capabilities": schema.ListAttribute{
+			Computed:    true,
+			Description: "Query behaviors the metric supports, as reported by the API",
+			ElementType: types.StringType,
+		},
+		"attributes": schema.ListAttribute{
+			Computed:    true,
+			Description: "Attributes the metric was reported with, usable in filters and `group_by`",
+			ElementType: types.StringType,
+		},
+	}
+}
+
+type MetricsModel struct {
+	ClusterId  types.String `tfsdk:"cluster_id"`
+	Window     types.String `tfsdk:"window"`
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	Type       types.String `tfsdk:"type"`
+	Metrics    types.List   `tfsdk:"metrics"`
+	Names      types.List   `tfsdk:"names"`
+}
+
+// MetricItemModel describes a metric, as an element of `metrics` or the top
+// level of the tsuga_metric data source.
+type MetricItemModel struct {
+	Name         types.String `tfsdk:"name"`
+	Type         types.String `tfsdk:"type"`
+	Unit         types.String `tfsdk:"unit"`
+	Temporality  types.String `tfsdk:"temporality"`
+	Capabilities types.List   `tfsdk:"capabilities"`
+	Attributes   types.List   `tfsdk:"attributes"`
+}
+
+// MetricAttrTypes returns the attribute types of a metrics element.
+func MetricAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":         types.StringType,
+		"type":         types.StringType,
+		"unit":         types.StringType,
+		"temporality":  types.StringType,
+		"capabilities": types.ListType{ElemType: types.StringType},
+		"attributes":   types.ListType{ElemType: types.StringType},
+	}
+}
@@ -0,0 +1,123 @@
+package datasource_monitor_backtest
+
+import (
+	"context"
+
+	"terraform-provider-tsuga/internal/datasourceschema"
+	"terraform-provider-tsuga/internal/resource_monitor"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func MonitorBacktestDataSourceSchema(ctx context.Context) schema.Schema {
+	configuration := datasourceschema.FromResource(resource_monitor.MonitorResourceSchema(ctx).Attributes["configuration"]).(schema.SingleNestedAttribute)
+	configuration.Description = "Monitor configuration to backtest, in the same format as the `configuration` of `tsuga_monitor`, so `tsuga_monitor.example.configuration` can be passed directly. Only `metric`, `log` and `trace` configurations can be backtested."
+
+	return schema.Schema{
+		Description: "Estimates how often a threshold monitor would have triggered over a past window. The queries are evaluated in buckets of the monitor's `timeframe` and the provider applies `conditions`, `group_by_fields`, `aggregation_alert_logic` and `no_data_behavior` to each bucket. Monitors evaluate a sliding timeframe every minute, so short breaches straddling two buckets can be missed.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Cluster to query, as listed by `tsuga_clusters`. Defaults to the provider's `cluster_id`, or the API's default cluster",
+			},
+			"window": schema.StringAttribute{
+				Required:    true,
+				Description: "Length of the backtested time range, ending at `to`, such as `7d`. It must be at least the monitor's `timeframe`",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"to": schema.StringAttribute{
+				Optional:    true,
+				Description: "End of the time range, as an RFC 3339 timestamp such as `2024-06-01T00:00:00Z`. Defaults to the time of the read. The range is aligned on multiples of the `timeframe`",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"configuration": configuration,
+			"evaluations": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of times the monitor was evaluated, one per `timeframe` in the window",
+			},
+			"trigger_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of times the monitor would have started alerting. With the `each` logic, every group alerts on its own and all their triggers are counted",
+			},
+			"triggered_at": schema.ListAttribute{
+				Computed:    true,
+				Description: "Times of the triggers, in order, as RFC 3339 timestamps at the end of the evaluated timeframe",
+				ElementType: types.StringType,
+			},
+			"first_triggered_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Time of the first trigger, or null when the monitor would not have triggered",
+			},
+			"last_triggered_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Time of the last trigger, or null when the monitor would not have triggered",
+			},
+			"groups": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Breakdown per group of `group_by_fields`, ordered by group values. An ungrouped monitor has a single group with an empty `group`",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"group": schema.MapAttribute{
+							Computed:    true,
+							Description: "Values of the grouped fields",
+							ElementType: types.StringType,
+						},
+						"trigger_count": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of times the group started alerting",
+						},
+						"first_triggered_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Time the group first started alerting, or null",
+						},
+						"last_triggered_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Time the group last started alerting, or null",
+						},
+						"breaching_evaluations": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of evaluations where every condition matched",
+						},
+						"no_data_evaluations": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of evaluations where a condition formula had no value for the group",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type MonitorBacktestModel struct {
+	ClusterId        types.String                               `tfsdk:"cluster_id"`
+	Window           types.String                               `tfsdk:"window"`
+	To               types.String                               `tfsdk:"to"`
+	Configuration    resource_monitor.MonitorConfigurationModel `tfsdk:"configuration"`
+	Evaluations      types.Int64                                `tfsdk:"evaluations"`
+	TriggerCount     types.Int64                                `tfsdk:"trigger_count"`
+	TriggeredAt      types.List                                 `tfsdk:"triggered_at"`
+	FirstTriggeredAt types.String                               `tfsdk:"first_triggered_at"`
+	LastTriggeredAt  types.String                               `tfsdk:"last_triggered_at"`
+	Groups           types.List                                 `tfsdk:"groups"`
+}
+
+// GroupAttrTypes returns the attribute types of a groups element.
+func GroupAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"group":                 types.MapType{ElemType: types.StringType},
+		"trigger_count":         types.Int64Type,
+		"first_triggered_at":    types.StringType,
+		"last_triggered_at":     types.StringType,
+		"breaching_evaluations": types.Int64Type,
+		"no_data_evaluations":   types.Int64Type,
+	}
+}
@@ -0,0 +1,210 @@
+package datasource_quality_report
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Severities are the diagnostics a failed assertion can produce.
+var Severities = []string{"error", "warning"}
+
+func QualityReportDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Reads the latest telemetry quality report of a cluster: the score and status of each quality rule, per team and for the whole cluster. With `minimum_score` or `rule_assertions`, a report below the thresholds fails the plan, or warns when `assertion_severity` is `warning`, so modules can be gated on telemetry quality.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Cluster whose report is read, as listed by `tsuga_clusters`. Defaults to the provider's `cluster_id`, or the API's default cluster",
+			},
+			"team": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the team whose rows are read. The rows of the whole cluster are then left out. Defaults to every team the caller can access and the whole cluster",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 250),
+				},
+			},
+			"minimum_score": schema.Float64Attribute{
+				Optional:    true,
+				Description: "Lowest acceptable overall score of each report, between 0 and 1. Every team report and the cluster-wide report read must reach it",
+				Validators: []validator.Float64{
+					float64validator.Between(0, 1),
+				},
+			},
+			"rule_assertions": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "Rules every report read must satisfy. Rows with the `ignored` status, which the rule did not apply to, always satisfy them. A rule without any row, such as a mistyped `rule_id`, does not",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"rule_id": schema.StringAttribute{
+							Required:    true,
+							Description: "Rule to check, such as `team-has-route` or `service-name-present`",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"minimum_score": schema.Float64Attribute{
+							Optional:    true,
+							Description: "Lowest acceptable score of the rule, between 0 and 1. Without it, the rule must not have the `failed` status",
+							Validators: []validator.Float64{
+								float64validator.Between(0, 1),
+							},
+						},
+					},
+				},
+			},
+			"assertion_severity": schema.StringAttribute{
+				Optional:    true,
+				Description: "Diagnostic produced when `minimum_score` or `rule_assertions` is not met: `error`, which fails the plan, or `warning`. Defaults to `error`",
+				Validators: []validator.String{
+					stringvalidator.OneOf(Severities...),
+				},
+			},
+			"rows": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The rows of the report, one per rule and team or per rule for the whole cluster, in the order returned by the API",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Row ID",
+						},
+						"report_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the report of the row, shared by the rows of a team or of the whole cluster",
+						},
+						"rule_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Rule evaluated, such as `team-has-route`",
+						},
+						"owner": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the team the row applies to, or null for the whole cluster",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "Outcome of the rule: `passed`, `failed`, or `ignored` when the rule did not apply to the data",
+						},
+						"score": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Score of the rule, between 0 and 1",
+						},
+						"weight": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Weight of the rule in the overall score: 1 for normal, 2 for important and 3 for critical rules",
+						},
+						"recommendation": schema.StringAttribute{
+							Computed:    true,
+							Description: "Recommendation to improve the score, or null",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Time the row was generated",
+						},
+					},
+				},
+			},
+			"reports": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The reports the rows belong to, one per team and one for the whole cluster, in the order of their first row",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"report_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Report ID",
+						},
+						"owner": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the team of the report, or null for the whole cluster",
+						},
+						"overall_score": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Overall score of the report, between 0 and 1, weighing the scores of the rules that were not ignored",
+						},
+						"total_weight": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Sum of the weights of the rules that were not ignored",
+						},
+						"failed_rules": schema.ListAttribute{
+							Computed:    true,
+							Description: "Rules with the `failed` status, in the order of the rows",
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+			"violations": schema.ListAttribute{
+				Computed:    true,
+				Description: "Descriptions of the unmet `minimum_score` and `rule_assertions`, empty when the report satisfies them",
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+type QualityReportModel struct {
+	ClusterId         types.String         `tfsdk:"cluster_id"`
+	Team              types.String         `tfsdk:"team"`
+	MinimumScore      types.Float64        `tfsdk:"minimum_score"`
+	RuleAssertions    []RuleAssertionModel `tfsdk:"rule_assertions"`
+	AssertionSeverity types.String         `tfsdk:"assertion_severity"`
+	Rows              types.List           `tfsdk:"rows"`
+	Reports           types.List           `tfsdk:"reports"`
+	Violations        types.List           `tfsdk:"violations"`
+}
+
+type RuleAssertionModel struct {
+	RuleId       types.String  `tfsdk:"rule_id"`
+	MinimumScore types.Float64 `tfsdk:"minimum_score"`
+}
+
+type RowModel struct {
+	Id             types.String  `tfsdk:"id"`
+	ReportId       types.String  `tfsdk:"report_id"`
+	RuleId         types.String  `tfsdk:"rule_id"`
+	Owner          types.String  `tfsdk:"owner"`
+	Status         types.String  `tfsdk:"status"`
+	Score          types.Float64 `tfsdk:"score"`
+	Weight         types.Float64 `tfsdk:"weight"`
+	Recommendation types.String  `tfsdk:"recommendation"`
+	CreatedAt      types.String  `tfsdk:"created_at"`
+}
+
+type ReportModel struct {
+	ReportId     types.String  `tfsdk:"report_id"`
+	Owner        types.String  `tfsdk:"owner"`
+	OverallScore types.Float64 `tfsdk:"overall_score"`
+	TotalWeight  types.Float64 `tfsdk:"total_weight"`
+	FailedRules  types.List    `tfsdk:"failed_rules"`
+}
+
+// RowAttrTypes returns the attribute types of a rows element.
+func RowAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":             types.StringType,
+		"report_id":      types.StringType,
+		"rule_id":        types.StringType,
+		"owner":          types.StringType,
+		"status":         types.StringType,
+		"score":          types.Float64Type,
+		"weight":         types.Float64Type,
+		"recommendation": types.StringType,
+		"created_at":     types.StringType,
+	}
+}
+
+// ReportAttrTypes returns the attribute types of a reports element.
+func ReportAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"report_id":     types.StringType,
+		"owner":         types.StringType,
+		"overall_score": types.Float64Type,
+		"total_weight":  types.Float64Type,
+		"failed_rules":  types.ListType{ElemType: types.StringType},
+	}
+}
@@ -0,0 +1,141 @@
+package datasource_query_value
+
+import (
+	"context"
+
+	"terraform-provider-tsuga/internal/datasourceschema"
+	"terraform-provider-tsuga/internal/resource_monitor"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Sources are the data sources an aggregation query can read.
+var Sources = []string{"logs", "metrics", "traces"}
+
+func QueryValueDataSourceSchema(ctx context.Context) schema.Schema {
+	queries := datasourceschema.FromResource(resource_monitor.QueriesSchema()).(schema.ListNestedAttribute)
+	queries.Required = false
+	queries.Optional = true
+	queries.Description = "Aggregation queries, in the same format as the `queries` of `tsuga_monitor` configurations. Requires `source`. Conflicts with `promql`."
+
+	groupBy := datasourceschema.FromResource(resource_monitor.GroupByFieldsSchema()).(schema.ListNestedAttribute)
+	groupBy.Required = false
+	groupBy.Optional = true
+	groupBy.Description = "Fields to group the results of `queries` by, in the same format as the `group_by_fields` of `tsuga_monitor` configurations. One result is returned per group."
+
+	return schema.Schema{
+		Description: "Runs a scalar query over a past time range and returns its numeric result, such as the p99 latency of the last 14 days, for deriving monitor thresholds from a baseline. The query is re-run on every plan, so the result follows the data unless `to` is set.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Cluster to query, as listed by `tsuga_clusters`. Defaults to the provider's `cluster_id`, or the API's default cluster",
+			},
+			"window": schema.StringAttribute{
+				Required:    true,
+				Description: "Length of the time range, ending at `to`, such as `1h` or `14d`",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"to": schema.StringAttribute{
+				Optional:    true,
+				Description: "End of the time range, as an RFC 3339 timestamp such as `2024-06-01T00:00:00Z`. Defaults to the time of the read",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"source": schema.StringAttribute{
+				Optional:    true,
+				Description: "Data the `queries` read: `logs`, `metrics` or `traces`",
+				Validators: []validator.String{
+					stringvalidator.OneOf(Sources...),
+				},
+			},
+			"queries":  queries,
+			"group_by": groupBy,
+			"formula": schema.StringAttribute{
+				Optional:    true,
+				Description: "Formula combining the `queries` into one value, such as `q1 / q2 * 100`. Without a formula, each query has its own result",
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(250),
+				},
+			},
+			"promql": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "PromQL query over metrics, run instead of `queries`. The result of each series is its last value in the time range",
+				Attributes: map[string]schema.Attribute{
+					"query": schema.StringAttribute{
+						Required:    true,
+						Description: "PromQL expression, such as `histogram_quantile(0.99, sum by (le) (rate(http_server_duration_bucket[5m])))`",
+						Validators: []validator.String{
+							stringvalidator.LengthBetween(1, 50000),
+						},
+					},
+					"step": schema.StringAttribute{
+						Optional:    true,
+						Description: "Resolution of the evaluated series, such as `5m`. Defaults to `window`, which evaluates the expression once at the end of the range",
+						Validators: []validator.String{
+							stringvalidator.LengthBetween(1, 250),
+						},
+					},
+				},
+			},
+			"value": schema.Float64Attribute{
+				Computed:    true,
+				Description: "Value of the first result of `formula` when it is set, otherwise of the first result, or null when the query returned none",
+			},
+			"results": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "All the results, in the order returned by the API",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "What the result belongs to: `q1`, `q2`, … for the queries in declaration order, `formula` for the formula, or the identifier of a PromQL series",
+						},
+						"group": schema.MapAttribute{
+							Computed:    true,
+							Description: "Values of the grouped fields or series labels, empty when the result is not grouped",
+							ElementType: types.StringType,
+						},
+						"value": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Numeric result",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type QueryValueModel struct {
+	ClusterId types.String  `tfsdk:"cluster_id"`
+	Window    types.String  `tfsdk:"window"`
+	To        types.String  `tfsdk:"to"`
+	Source    types.String  `tfsdk:"source"`
+	Queries   types.List    `tfsdk:"queries"`
+	GroupBy   types.List    `tfsdk:"group_by"`
+	Formula   types.String  `tfsdk:"formula"`
+	Promql    *PromqlModel  `tfsdk:"promql"`
+	Value     types.Float64 `tfsdk:"value"`
+	Results   types.List    `tfsdk:"results"`
+}
+
+type PromqlModel struct {
+	Query types.String `tfsdk:"query"`
+	Step  types.String `tfsdk:"step"`
+}
+
+// ResultAttrTypes returns the attribute types of a results element.
+func ResultAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":    types.StringType,
+		"group": types.MapType{ElemType: types.StringType},
+		"value": types.Float64Type,
+	}
+}
@@ -0,0 +1,83 @@
+package datasource_route_simulation
+
+import (
+	"context"
+
+	"terraform-provider-tsuga/internal/datasourceschema"
+	"terraform-provider-tsuga/internal/resource_route"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func RouteSimulationDataSourceSchema(ctx context.Context) schema.Schema {
+	processors := datasourceschema.FromResource(resource_route.RouteResourceSchema(ctx).Attributes["processors"]).(schema.ListNestedAttribute)
+	processors.Required = false
+	processors.Optional = true
+	processors.Description = "Processors to simulate, in the same format as the `processors` attribute of `tsuga_route`, so `tsuga_route.example.processors` can be passed directly. Conflicts with `route_id`."
+
+	return schema.Schema{
+		Description: "Runs sample log events through a route processor pipeline locally and returns the transformed events. Mapper, parse_attribute, creator and split processors are interpreted offline, so pipelines can be asserted on in `terraform test` without ingesting logs. URL and user-agent parsers write a best-effort subset of fields under `<source_attribute>_details`.",
+		Attributes: map[string]schema.Attribute{
+			"route_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of an existing route whose processors are simulated. Conflicts with `processors`.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"processors": processors,
+			"events": schema.ListAttribute{
+				Required:    true,
+				Description: "Sample log events, each a JSON object such as `jsonencode({ message = \"...\" })`",
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 100),
+					listvalidator.ValueStringsAre(stringvalidator.LengthBetween(2, 100000)),
+				},
+			},
+			"results": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Simulation result for each event, in the same order as `events`",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"event": schema.StringAttribute{
+							Computed:    true,
+							Description: "JSON-encoded event after all processors ran; use `jsondecode` to read it",
+						},
+						"applied_processors": schema.ListAttribute{
+							Computed:    true,
+							Description: "IDs of the processors the event went through, in order, including split processors and the processors of the branches taken",
+							ElementType: types.StringType,
+						},
+						"skipped_processors": schema.ListAttribute{
+							Computed:    true,
+							Description: "Processors that could not be applied to the event, formatted as `<id>: <reason>`. Processing continues after a skipped processor.",
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type RouteSimulationModel struct {
+	RouteId    types.String `tfsdk:"route_id"`
+	Processors types.List   `tfsdk:"processors"`
+	Events     types.List   `tfsdk:"events"`
+	Results    types.List   `tfsdk:"results"`
+}
+
+// ResultAttrTypes returns the attribute types of a results element.
+func ResultAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"event":              types.StringType,
+		"applied_processors": types.ListType{ElemType: types.StringType},
+		"skipped_processors": types.ListType{ElemType: types.StringType},
+	}
+}
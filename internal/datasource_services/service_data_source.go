@@ -0,0 +1,114 @@
+package datasource_services
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func ServiceDataSourceSchema(ctx context.Context) schema.Schema {
+	attributes := serviceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Required:    true,
+		Description: "ID of the service, as listed by `tsuga_services`",
+		Validators: []validator.String{
+			stringvalidator.LengthBetween(1, 250),
+		},
+	}
+	attributes["graph_window"] = schema.StringAttribute{
+		Optional:    true,
+		Description: "Time window before the read over which the service graph is built, such as `1h` or `7d`. Defaults to `1h`",
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+	attributes["graph_query"] = schema.StringAttribute{
+		Optional:    true,
+		Description: "Tsuga query filtering the spans the service graph is built from. Defaults to all spans of the service",
+		Validators: []validator.String{
+			stringvalidator.LengthBetween(1, 10000),
+		},
+	}
+	attributes["graph_edges"] = schema.ListNestedAttribute{
+		Computed:    true,
+		Description: "Calls between services in the graph around the service, in the order returned by the API",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"from": schema.StringAttribute{
+					Computed:    true,
+					Description: "Name of the calling service",
+				},
+				"from_env": schema.StringAttribute{
+					Computed:    true,
+					Description: "Environment of the calling service, if reported",
+				},
+				"to": schema.StringAttribute{
+					Computed:    true,
+					Description: "Name of the called service or database",
+				},
+				"to_env": schema.StringAttribute{
+					Computed:    true,
+					Description: "Environment of the called service, if reported",
+				},
+				"database_system": schema.StringAttribute{
+					Computed:    true,
+					Description: "Database system of the called database, such as `postgresql`, if the callee is one",
+				},
+				"count": schema.Int64Attribute{
+					Computed:    true,
+					Description: "Calls over the window",
+				},
+				"error_count": schema.Int64Attribute{
+					Computed:    true,
+					Description: "Failed calls over the window",
+				},
+				"client_span_count": schema.Int64Attribute{
+					Computed:    true,
+					Description: "Client spans of the calls over the window",
+				},
+			},
+		},
+	}
+	attributes["dependencies"] = schema.ListAttribute{
+		Computed:    true,
+		Description: "Names of the services and databases the service calls, from `graph_edges`",
+		ElementType: types.StringType,
+	}
+	attributes["dependents"] = schema.ListAttribute{
+		Computed:    true,
+		Description: "Names of the services calling the service, from `graph_edges`",
+		ElementType: types.StringType,
+	}
+
+	return schema.Schema{
+		Description: "Reads a service Tsuga inferred from telemetry, with the services it calls and is called by, for dependency-aware alerting.",
+		Attributes:  attributes,
+	}
+}
+
+type ServiceModel struct {
+	ServiceItemModel
+	GraphWindow  types.String `tfsdk:"graph_window"`
+	GraphQuery   types.String `tfsdk:"graph_query"`
+	GraphEdges   types.List   `tfsdk:"graph_edges"`
+	Dependencies types.List   `tfsdk:"dependencies"`
+	Dependents   types.List   `tfsdk:"dependents"`
+}
+
+// GraphEdgeAttrTypes returns the attribute types of a graph_edges element.
+func GraphEdgeAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"from":              types.StringType,
+		"from_env":          types.StringType,
+		"to":                types.StringType,
+		"to_env":            types.StringType,
+		"database_system":   types.StringType,
+		"count":             types.Int64Type,
+		"error_count":       types.Int64Type,
+		"client_span_count": types.Int64Type,
+	}
+}
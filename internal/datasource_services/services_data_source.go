@@ -0,0 +1,177 @@
+package datasource_services
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func ServicesDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Lists the services Tsuga inferred from telemetry, with their owning teams, runtimes and recent activity, for generating per-service monitors and SLOs with `for_each`. Every filter set must match.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": clusterIDAttribute(),
+			"team": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list services seen with this team, compared case-insensitively",
+			},
+			"language": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list services seen with this language, such as `go` or `python`, compared case-insensitively",
+			},
+			"cloud_provider": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list services seen on this cloud provider, such as `aws`, compared case-insensitively",
+			},
+			"last_seen_within": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list services seen within this duration before the read, such as `24h` or `7d`",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"services": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching services, in the order returned by the API",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: serviceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func clusterIDAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Description: "Cluster to read, as listed by `tsuga_clusters`. Defaults to the provider's `cluster_id`, or the API's default cluster",
+	}
+}
+
+// serviceAttributes returns the computed attributes describing a service.
+func serviceAttributes() map[string]schema.Attribute {
+	stringList := func(description string) schema.ListAttribute {
+		return schema.ListAttribute{
+			Computed:    true,
+			Description: description,
+			ElementType: types.StringType,
+		}
+	}
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "Service ID",
+		},
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: "Service name, as reported by its telemetry",
+		},
+		"namespace": schema.StringAttribute{
+			Computed:    true,
+			Description: "Service namespace, if reported",
+		},
+		"env": schema.StringAttribute{
+			Computed:    true,
+			Description: "Environment of the service, if reported",
+		},
+		"teams":            stringList("Teams the service was seen with"),
+		"versions":         stringList("Versions the service was seen running"),
+		"languages":        stringList("Languages the service was seen using"),
+		"runtimes":         stringList("Runtimes the service was seen using"),
+		"frameworks":       stringList("Frameworks the service was seen using"),
+		"server_protocols": stringList("Protocols the service was seen serving"),
+		"client_protocols": stringList("Protocols the service was seen calling other services with"),
+		"cloud_platforms":  stringList("Cloud platforms the service was seen on"),
+		"cloud_providers":  stringList("Cloud providers the service was seen on"),
+		"sources":          stringList("Telemetry sources the service was inferred from"),
+		"first_seen_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "When the service was first seen, in RFC 3339 format",
+		},
+		"last_seen_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "When the service was last seen, in RFC 3339 format",
+		},
+		"logs_count_24h": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Logs of the service over the last 24 hours",
+		},
+		"error_logs_count_24h": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Error logs of the service over the last 24 hours",
+		},
+		"traces_count_24h": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Traces of the service over the last 24 hours",
+		},
+		"error_traces_count_24h": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Error traces of the service over the last 24 hours",
+		},
+	}
+}
+
+type ServicesModel struct {
+	ClusterId      types.String `tfsdk:"cluster_id"`
+	Team           types.String `tfsdk:"team"`
+	Language       types.String `tfsdk:"language"`
+	CloudProvider  types.String `tfsdk:"cloud_provider"`
+	LastSeenWithin types.String `tfsdk:"last_seen_within"`
+	Services       types.List   `tfsdk:"services"`
+}
+
+// ServiceItemModel describes a service, as an element of `services` or the top
+// level of the tsuga_service data source.
+type ServiceItemModel struct {
+	Id                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Namespace           types.String `tfsdk:"namespace"`
+	Env                 types.String `tfsdk:"env"`
+	Teams               types.List   `tfsdk:"teams"`
+	Versions            types.List   `tfsdk:"versions"`
+	Languages           types.List   `tfsdk:"languages"`
+	Runtimes            types.List   `tfsdk:"runtimes"`
+	Frameworks          types.List   `tfsdk:"frameworks"`
+	ServerProtocols     types.List   `tfsdk:"server_protocols"`
+	ClientProtocols     types.List   `tfsdk:"client_protocols"`
+	CloudPlatforms      types.List   `tfsdk:"cloud_platforms"`
+	CloudProviders      types.List   `tfsdk:"cloud_providers"`
+	Sources             types.List   `tfsdk:"sources"`
+	FirstSeenAt         types.String `tfsdk:"first_seen_at"`
+	LastSeenAt          types.String `tfsdk:"last_seen_at"`
+	LogsCount24h        types.Int64  `tfsdk:"logs_count_24h"`
+	ErrorLogsCount24h   types.Int64  `tfsdk:"error_logs_count_24h"`
+	TracesCount24h      types.Int64  `tfsdk:"traces_count_24h"`
+	ErrorTracesCount24h types.Int64  `tfsdk:"error_traces_count_24h"`
+}
+
+// ServiceAttrTypes returns the attribute types of a services element.
+func ServiceAttrTypes() map[string]attr.Type {
+	stringList := types.ListType{ElemType: types.StringType}
+	return map[string]attr.Type{
+		"id":                     types.StringType,
+		"name":                   types.StringType,
+		"namespace":              types.StringType,
+		"env":                    types.StringType,
+		"teams":                  stringList,
+		"versions":               stringList,
+		"languages":              stringList,
+		"runtimes":               stringList,
+		"frameworks":             stringList,
+		"server_protocols":       stringList,
+		"client_protocols":       stringList,
+		"cloud_platforms":        stringList,
+		"cloud_providers":        stringList,
+		"sources":                stringList,
+		"first_seen_at":          types.StringType,
+		"last_seen_at":           types.StringType,
+		"logs_count_24h":         types.Int64Type,
+		"error_logs_count_24h":   types.Int64Type,
+		"traces_count_24h":       types.Int64Type,
+		"error_traces_count_24h": types.Int64Type,
+	}
+}
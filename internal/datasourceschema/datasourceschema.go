@@ -0,0 +1,111 @@
+// Package datasourceschema converts resource schema attributes into their data source
+// equivalents, so data sources can take inputs written exactly like the attributes of
+// a resource, such as the processors of a route or the queries of a monitor.
+package datasourceschema
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// FromResource converts a resource attribute into its data source equivalent, keeping
+// descriptions, validators and custom types so that values of the resource attribute
+// can be assigned to it. Plan modifiers and defaults have no data source counterpart
+// and are dropped. It panics on attribute types no converted schema uses yet.
+func FromResource(a resourceschema.Attribute) schema.Attribute {
+	switch a := a.(type) {
+	case resourceschema.StringAttribute:
+		return schema.StringAttribute{
+			Required:    a.Required,
+			Optional:    a.Optional,
+			Computed:    a.Computed,
+			Sensitive:   a.Sensitive,
+			Description: a.Description,
+			CustomType:  a.CustomType,
+			Validators:  a.Validators,
+		}
+	case resourceschema.BoolAttribute:
+		return schema.BoolAttribute{
+			Required:    a.Required,
+			Optional:    a.Optional,
+			Computed:    a.Computed,
+			Description: a.Description,
+			CustomType:  a.CustomType,
+			Validators:  a.Validators,
+		}
+	case resourceschema.Int64Attribute:
+		return schema.Int64Attribute{
+			Required:    a.Required,
+			Optional:    a.Optional,
+			Computed:    a.Computed,
+			Description: a.Description,
+			CustomType:  a.CustomType,
+			Validators:  a.Validators,
+		}
+	case resourceschema.Float64Attribute:
+		return schema.Float64Attribute{
+			Required:    a.Required,
+			Optional:    a.Optional,
+			Computed:    a.Computed,
+			Description: a.Description,
+			CustomType:  a.CustomType,
+			Validators:  a.Validators,
+		}
+	case resourceschema.ListAttribute:
+		return schema.ListAttribute{
+			Required:    a.Required,
+			Optional:    a.Optional,
+			Computed:    a.Computed,
+			Description: a.Description,
+			ElementType: a.ElementType,
+			CustomType:  a.CustomType,
+			Validators:  a.Validators,
+		}
+	case resourceschema.MapAttribute:
+		return schema.MapAttribute{
+			Required:    a.Required,
+			Optional:    a.Optional,
+			Computed:    a.Computed,
+			Description: a.Description,
+			ElementType: a.ElementType,
+			CustomType:  a.CustomType,
+			Validators:  a.Validators,
+		}
+	case resourceschema.ListNestedAttribute:
+		return schema.ListNestedAttribute{
+			Required:    a.Required,
+			Optional:    a.Optional,
+			Computed:    a.Computed,
+			Description: a.Description,
+			CustomType:  a.CustomType,
+			Validators:  a.Validators,
+			NestedObject: schema.NestedAttributeObject{
+				CustomType: a.NestedObject.CustomType,
+				Attributes: FromResourceAttributes(a.NestedObject.Attributes),
+			},
+		}
+	case resourceschema.SingleNestedAttribute:
+		return schema.SingleNestedAttribute{
+			Required:    a.Required,
+			Optional:    a.Optional,
+			Computed:    a.Computed,
+			Description: a.Description,
+			CustomType:  a.CustomType,
+			Validators:  a.Validators,
+			Attributes:  FromResourceAttributes(a.Attributes),
+		}
+	default:
+		panic(fmt.Sprintf("datasourceschema: unsupported resource schema attribute type %T", a))
+	}
+}
+
+// FromResourceAttributes converts each attribute of a nested resource object.
+func FromResourceAttributes(attrs map[string]resourceschema.Attribute) map[string]schema.Attribute {
+	out := make(map[string]schema.Attribute, len(attrs))
+	for name, a := range attrs {
+		out[name] = FromResource(a)
+	}
+	return out
+}
@@ -0,0 +1,494 @@
+// Package filterexpression implements the structured `filter_expression` attribute, an
+// alternative to raw query strings. Expressions are AND/OR groups of field terms that
+// the provider compiles to Tsuga query syntax, quoting and escaping every value so
+// that quotes, colons or keywords in values cannot change the meaning of the query.
+package filterexpression
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// MaxDepth is the number of group levels an expression can have, counting the
+// top-level expression. Terraform schemas cannot be recursive.
+const MaxDepth = 3
+
+// Operators lists the term operators.
+var Operators = []string{
+	"equals", "not_equals", "wildcard", "exists", "not_exists",
+	"greater_than", "greater_than_or_equal", "less_than", "less_than_or_equal", "between",
+}
+
+// Schema returns the schema of an optional filter_expression attribute.
+func Schema(description string) schema.Attribute {
+	a := schemaAtDepth(MaxDepth)
+	a.Optional = true
+	a.Description = description
+	return a
+}
+
+func schemaAtDepth(depth int) schema.SingleNestedAttribute {
+	attrs := map[string]schema.Attribute{
+		"operator": schema.StringAttribute{
+			Optional:    true,
+			Description: "How the terms and groups are combined, `and` (default) or `or`",
+			Validators: []validator.String{
+				stringvalidator.OneOf("and", "or"),
+			},
+		},
+		"negate": schema.BoolAttribute{
+			Optional:    true,
+			Description: "Whether to match the logs that the group does not match",
+		},
+		"terms": schema.ListNestedAttribute{
+			Optional:    true,
+			Description: "Field conditions of the group",
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(50),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"field": schema.StringAttribute{
+						Optional:    true,
+						Description: "Attribute to match, such as `service` or `http.status_code`. Omit to search the log message as free text.",
+						Validators: []validator.String{
+							stringvalidator.LengthBetween(1, 250),
+						},
+					},
+					"operator": schema.StringAttribute{
+						Required:    true,
+						Description: "One of `equals`, `not_equals` and `wildcard`, which accept several values matched with OR, `exists` and `not_exists`, which take no value, `greater_than`, `greater_than_or_equal`, `less_than` and `less_than_or_equal`, which take one value, and `between`, which takes an inclusive lower and upper bound. `wildcard` values may contain `*` and `?`.",
+						Validators: []validator.String{
+							stringvalidator.OneOf(Operators...),
+						},
+					},
+					"values": schema.ListAttribute{
+						Optional:    true,
+						Description: "Values to compare the field with. They are quoted and escaped by the provider.",
+						ElementType: types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(100),
+						},
+					},
+				},
+			},
+		},
+	}
+	if depth > 1 {
+		group := schemaAtDepth(depth - 1)
+		attrs["groups"] = schema.ListNestedAttribute{
+			Optional:    true,
+			Description: "Nested groups, combined with the terms by `operator`",
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(20),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: group.Attributes,
+			},
+		}
+	}
+	return schema.SingleNestedAttribute{Attributes: attrs}
+}
+
+// AttrTypes returns the attribute types of a filter_expression object.
+func AttrTypes() map[string]attr.Type {
+	return attrTypesAtDepth(MaxDepth)
+}
+
+func attrTypesAtDepth(depth int) map[string]attr.Type {
+	attrTypes := map[string]attr.Type{
+		"operator": types.StringType,
+		"negate":   types.BoolType,
+		"terms": types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{
+			"field":    types.StringType,
+			"operator": types.StringType,
+			"values":   types.ListType{ElemType: types.StringType},
+		}}},
+	}
+	if depth > 1 {
+		attrTypes["groups"] = types.ListType{ElemType: types.ObjectType{AttrTypes: attrTypesAtDepth(depth - 1)}}
+	}
+	return attrTypes
+}
+
+// Null returns a null filter_expression, used when flattening API responses.
+func Null() types.Object {
+	return types.ObjectNull(AttrTypes())
+}
+
+// ErrUnknown is returned by Compile when part of the expression is not known yet.
+var ErrUnknown = errors.New("filter expression is not known yet")
+
+// Error reports an invalid expression. Path locates the problem relative to the
+// filter_expression attribute, such as `.groups[0].terms[1]`, and is empty for the
+// attribute itself.
+type Error struct {
+	Path string
+	Msg  string
+}
+
+func (e *Error) Error() string {
+	if e.Path == "" {
+		return e.Msg
+	}
+	return fmt.Sprintf("%s: %s", strings.TrimPrefix(e.Path, "."), e.Msg)
+}
+
+// Compile converts a filter_expression object into a query string. Invalid expressions
+// are reported as *Error, and expressions with unknown values as ErrUnknown.
+func Compile(ctx context.Context, obj types.Object) (string, error) {
+	v, err := obj.ToTerraformValue(ctx)
+	if err != nil {
+		return "", err
+	}
+	return compileValue(v)
+}
+
+func compileValue(v tftypes.Value) (string, error) {
+	e, err := decodeGroup(v, "")
+	if err != nil {
+		return "", err
+	}
+	return e.compile(false), nil
+}
+
+type group struct {
+	or     bool
+	negate bool
+	terms  []term
+	groups []group
+}
+
+type term struct {
+	field    string
+	operator string
+	values   []string
+}
+
+func decodeGroup(v tftypes.Value, path string) (group, error) {
+	var g group
+	attrs, err := objectAttributes(v)
+	if err != nil {
+		return g, err
+	}
+
+	operator, err := stringAttribute(attrs["operator"])
+	if err != nil {
+		return g, err
+	}
+	g.or = operator == "or"
+	if negate := attrs["negate"]; !negate.IsNull() {
+		if !negate.IsKnown() {
+			return g, ErrUnknown
+		}
+		if err := negate.As(&g.negate); err != nil {
+			return g, err
+		}
+	}
+
+	terms, err := listElements(attrs["terms"])
+	if err != nil {
+		return g, err
+	}
+	for i, tv := range terms {
+		t, err := decodeTerm(tv, fmt.Sprintf("%s.terms[%d]", path, i))
+		if err != nil {
+			return g, err
+		}
+		g.terms = append(g.terms, t)
+	}
+
+	groups, err := listElements(attrs["groups"])
+	if err != nil {
+		return g, err
+	}
+	for i, gv := range groups {
+		nested, err := decodeGroup(gv, fmt.Sprintf("%s.groups[%d]", path, i))
+		if err != nil {
+			return g, err
+		}
+		g.groups = append(g.groups, nested)
+	}
+
+	if len(g.terms) == 0 && len(g.groups) == 0 {
+		return g, &Error{Path: path, Msg: "at least one term or group is required"}
+	}
+	return g, nil
+}
+
+func decodeTerm(v tftypes.Value, path string) (term, error) {
+	var t term
+	attrs, err := objectAttributes(v)
+	if err != nil {
+		return t, err
+	}
+	if t.field, err = stringAttribute(attrs["field"]); err != nil {
+		return t, err
+	}
+	if t.operator, err = stringAttribute(attrs["operator"]); err != nil {
+		return t, err
+	}
+	values, err := listElements(attrs["values"])
+	if err != nil {
+		return t, err
+	}
+	for _, vv := range values {
+		s, err := stringAttribute(vv)
+		if err != nil {
+			return t, err
+		}
+		t.values = append(t.values, s)
+	}
+
+	fail := func(format string, args ...any) (term, error) {
+		return t, &Error{Path: path, Msg: fmt.Sprintf(format, args...)}
+	}
+	switch t.operator {
+	case "equals", "not_equals", "wildcard":
+		if len(t.values) == 0 {
+			return fail("the %q operator requires at least one value", t.operator)
+		}
+		if t.operator == "wildcard" {
+			for _, s := range t.values {
+				if s == "" {
+					return fail("wildcard patterns cannot be empty")
+				}
+			}
+		}
+	case "exists", "not_exists":
+		if len(t.values) != 0 {
+			return fail("the %q operator takes no values", t.operator)
+		}
+	case "greater_than", "greater_than_or_equal", "less_than", "less_than_or_equal":
+		if len(t.values) != 1 {
+			return fail("the %q operator requires exactly one value", t.operator)
+		}
+	case "between":
+		if len(t.values) != 2 {
+			return fail("the \"between\" operator requires exactly two values, the lower and upper bounds")
+		}
+	default:
+		return fail("unsupported operator %q", t.operator)
+	}
+
+	if t.field == "" {
+		switch t.operator {
+		case "equals", "not_equals", "wildcard":
+		default:
+			return fail("the %q operator requires a field", t.operator)
+		}
+	}
+	return t, nil
+}
+
+func objectAttributes(v tftypes.Value) (map[string]tftypes.Value, error) {
+	if !v.IsKnown() {
+		return nil, ErrUnknown
+	}
+	var attrs map[string]tftypes.Value
+	if err := v.As(&attrs); err != nil {
+		return nil, err
+	}
+	return attrs, nil
+}
+
+// stringAttribute returns the value of a string, or "" when it is null or absent.
+func stringAttribute(v tftypes.Value) (string, error) {
+	if v.Type() == nil || v.IsNull() {
+		return "", nil
+	}
+	if !v.IsKnown() {
+		return "", ErrUnknown
+	}
+	var s string
+	err := v.As(&s)
+	return s, err
+}
+
+// listElements returns the elements of a list, or nothing when it is null or absent.
+func listElements(v tftypes.Value) ([]tftypes.Value, error) {
+	if v.Type() == nil || v.IsNull() {
+		return nil, nil
+	}
+	if !v.IsKnown() {
+		return nil, ErrUnknown
+	}
+	var elems []tftypes.Value
+	err := v.As(&elems)
+	return elems, err
+}
+
+// compile renders the group. Nested groups with several operands are parenthesized,
+// so the result does not depend on the precedence of AND over OR.
+func (g group) compile(nested bool) string {
+	parts := make([]string, 0, len(g.terms)+len(g.groups))
+	for _, t := range g.terms {
+		parts = append(parts, t.compile())
+	}
+	for _, ng := range g.groups {
+		parts = append(parts, ng.compile(true))
+	}
+
+	joiner := " AND "
+	if g.or {
+		joiner = " OR "
+	}
+	s := strings.Join(parts, joiner)
+	if len(parts) > 1 && (nested || g.negate) {
+		s = "(" + s + ")"
+	}
+	if g.negate {
+		s = "NOT " + s
+	}
+	return s
+}
+
+func (t term) compile() string {
+	prefix := ""
+	if t.field != "" {
+		prefix = escapeWord(t.field, false, true) + ":"
+	}
+
+	switch t.operator {
+	case "equals", "not_equals", "wildcard":
+		values := make([]string, len(t.values))
+		for i, v := range t.values {
+			if t.operator == "wildcard" {
+				values[i] = escapeWord(v, true, t.field == "")
+			} else {
+				values[i] = quote(v)
+			}
+		}
+		s := values[0]
+		if len(values) > 1 {
+			s = "(" + strings.Join(values, " OR ") + ")"
+		}
+		if t.operator != "not_equals" {
+			return prefix + s
+		}
+		if t.field == "" {
+			return "NOT " + s
+		}
+		return "-" + prefix + s
+	case "exists":
+		return prefix + "*"
+	case "not_exists":
+		return "-" + prefix + "*"
+	case "greater_than":
+		return prefix + ">" + escapeWord(t.values[0], false, false)
+	case "greater_than_or_equal":
+		return prefix + ">=" + escapeWord(t.values[0], false, false)
+	case "less_than":
+		return prefix + "<" + escapeWord(t.values[0], false, false)
+	case "less_than_or_equal":
+		return prefix + "<=" + escapeWord(t.values[0], false, false)
+	default: // between
+		return fmt.Sprintf("%s[%s TO %s]", prefix, escapeWord(t.values[0], false, false), escapeWord(t.values[1], false, false))
+	}
+}
+
+// quote returns s as a quoted phrase, which matches the value exactly.
+func quote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+// escapeWord escapes s so that it is read back as a single literal word. Wildcards are
+// kept when pattern is set. A leading "-" is escaped when the word starts a term, where
+// it would otherwise negate it.
+func escapeWord(s string, pattern, startsTerm bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch c {
+		case ' ', '\t', '\n', '\r', '(', ')', ':', '"', '[', ']', '{', '}', '\\', '<', '>', '=':
+			b.WriteByte('\\')
+		case '*', '?':
+			if !pattern {
+				b.WriteByte('\\')
+			}
+		case '-':
+			if i == 0 && startsTerm {
+				b.WriteByte('\\')
+			}
+		}
+		b.WriteByte(c)
+	}
+	switch s {
+	case "AND", "OR", "NOT", "TO", "&&", "||":
+		return `\` + b.String()
+	}
+	return b.String()
+}
+
+// querySiblings are the query string attributes a filter_expression can replace.
+var querySiblings = []string{"filter", "query", "query_string"}
+
+// Preserve keeps the structured form of queries across a read. API responses only
+// hold query strings, so after flattening, every filter_expression in current is null
+// and its sibling filter, query or query_string attribute holds the string. Where the
+// expression at the same path in prior compiles to that string, Preserve restores the
+// expression and the sibling attribute from prior, so configurations using
+// filter_expression show no difference. Queries changed outside Terraform keep the
+// string from the API and appear as drift.
+func Preserve(prior, current tftypes.Value) (tftypes.Value, error) {
+	if prior.IsNull() || !prior.IsKnown() {
+		return current, nil
+	}
+	return tftypes.Transform(current, func(p *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if !v.Type().Is(tftypes.Object{}) || v.IsNull() || !v.IsKnown() {
+			return v, nil
+		}
+		var attrs map[string]tftypes.Value
+		if err := v.As(&attrs); err != nil {
+			return v, err
+		}
+		if _, ok := attrs["filter_expression"]; !ok {
+			return v, nil
+		}
+
+		priorRaw, _, err := tftypes.WalkAttributePath(prior, p)
+		if err != nil {
+			return v, nil
+		}
+		priorValue, ok := priorRaw.(tftypes.Value)
+		if !ok || !priorValue.Type().Equal(v.Type()) || priorValue.IsNull() || !priorValue.IsKnown() {
+			return v, nil
+		}
+		var priorAttrs map[string]tftypes.Value
+		if err := priorValue.As(&priorAttrs); err != nil {
+			return v, err
+		}
+		expr := priorAttrs["filter_expression"]
+		if expr.IsNull() {
+			return v, nil
+		}
+		compiled, err := compileValue(expr)
+		if err != nil {
+			return v, nil
+		}
+
+		for _, name := range querySiblings {
+			sibling, ok := attrs[name]
+			if !ok {
+				continue
+			}
+			if current, err := stringAttribute(sibling); err != nil || current != compiled {
+				return v, nil
+			}
+			attrs[name] = priorAttrs[name]
+			attrs["filter_expression"] = expr
+			return tftypes.NewValue(v.Type(), attrs), nil
+		}
+		return v, nil
+	})
+}
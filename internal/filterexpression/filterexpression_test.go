@@ -0,0 +1,212 @@
+package filterexpression
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"terraform-provider-tsuga/internal/query"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func termValue(field, operator string, values ...string) attr.Value {
+	fieldValue := types.StringNull()
+	if field != "" {
+		fieldValue = types.StringValue(field)
+	}
+	valuesValue := types.ListNull(types.StringType)
+	if values != nil {
+		elems := make([]attr.Value, len(values))
+		for i, v := range values {
+			elems[i] = types.StringValue(v)
+		}
+		valuesValue = types.ListValueMust(types.StringType, elems)
+	}
+	return types.ObjectValueMust(attrTypesAtDepth(1)["terms"].(types.ListType).ElemType.(types.ObjectType).AttrTypes, map[string]attr.Value{
+		"field":    fieldValue,
+		"operator": types.StringValue(operator),
+		"values":   valuesValue,
+	})
+}
+
+func groupValue(depth int, operator string, negate bool, terms []attr.Value, groups []attr.Value) types.Object {
+	attrTypes := attrTypesAtDepth(depth)
+	values := map[string]attr.Value{
+		"operator": types.StringNull(),
+		"negate":   types.BoolValue(negate),
+		"terms":    types.ListValueMust(attrTypes["terms"].(types.ListType).ElemType, terms),
+	}
+	if operator != "" {
+		values["operator"] = types.StringValue(operator)
+	}
+	if depth > 1 {
+		values["groups"] = types.ListValueMust(attrTypes["groups"].(types.ListType).ElemType, groups)
+	}
+	return types.ObjectValueMust(attrTypes, values)
+}
+
+func TestCompile(t *testing.T) {
+	ctx := context.Background()
+	cases := []struct {
+		name string
+		expr types.Object
+		want string
+	}{
+		{
+			name: "terms joined with AND",
+			expr: groupValue(MaxDepth, "", false, []attr.Value{
+				termValue("service", "equals", "api"),
+				termValue("status_code", "greater_than_or_equal", "500"),
+			}, nil),
+			want: `service:"api" AND status_code:>=500`,
+		},
+		{
+			name: "values needing escapes",
+			expr: groupValue(MaxDepth, "or", false, []attr.Value{
+				termValue("message", "equals", `say "hi"`, `C:\tmp`),
+				termValue("http.url", "wildcard", "https://example.com/a b*"),
+				termValue("", "not_equals", "OR"),
+			}, nil),
+			want: `message:("say \"hi\"" OR "C:\\tmp") OR http.url:https\://example.com/a\ b* OR NOT "OR"`,
+		},
+		{
+			name: "nested groups",
+			expr: groupValue(MaxDepth, "and", false, []attr.Value{
+				termValue("env", "not_exists"),
+			}, []attr.Value{
+				groupValue(MaxDepth-1, "or", true, []attr.Value{
+					termValue("level", "equals", "error"),
+					termValue("duration", "between", "-5", "10"),
+				}, []attr.Value{}),
+			}),
+			want: `-env:* AND NOT (level:"error" OR duration:[-5 TO 10])`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Compile(ctx, tc.expr)
+			if err != nil {
+				t.Fatalf("Compile() returned error: %s", err)
+			}
+			if got != tc.want {
+				t.Errorf("Compile() = %s, want %s", got, tc.want)
+			}
+			if _, err := query.Parse(got); err != nil {
+				t.Errorf("compiled query %q does not parse: %s", got, err)
+			}
+		})
+	}
+}
+
+func TestCompileMatchesLiteralValues(t *testing.T) {
+	ctx := context.Background()
+	for _, value := range []string{`a "quoted" value`, "key:value", "AND", "-leading", "(x)", `back\slash`, "*"} {
+		expr := groupValue(MaxDepth, "", false, []attr.Value{termValue("attr", "equals", value)}, nil)
+		compiled, err := Compile(ctx, expr)
+		if err != nil {
+			t.Fatalf("Compile(%q) returned error: %s", value, err)
+		}
+		q, err := query.Parse(compiled)
+		if err != nil {
+			t.Fatalf("compiled query %q does not parse: %s", compiled, err)
+		}
+		lookup := func(v string) query.Lookup {
+			return func(field string) (any, bool) { return v, field == "attr" }
+		}
+		if !q.Match(lookup(value)) {
+			t.Errorf("%s does not match the value %q", compiled, value)
+		}
+		if q.Match(lookup(value + "x")) {
+			t.Errorf("%s matches %q", compiled, value+"x")
+		}
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	ctx := context.Background()
+	cases := []struct {
+		expr types.Object
+		want string
+	}{
+		{groupValue(MaxDepth, "", false, []attr.Value{}, nil), "at least one term or group is required"},
+		{groupValue(MaxDepth, "", false, []attr.Value{termValue("a", "equals")}, nil), `terms[0]: the "equals" operator requires at least one value`},
+		{groupValue(MaxDepth, "", false, []attr.Value{termValue("a", "exists", "x")}, nil), `terms[0]: the "exists" operator takes no values`},
+		{groupValue(MaxDepth, "", false, []attr.Value{termValue("", "greater_than", "1")}, nil), `terms[0]: the "greater_than" operator requires a field`},
+		{
+			groupValue(MaxDepth, "", false, []attr.Value{termValue("a", "exists")}, []attr.Value{
+				groupValue(MaxDepth-1, "", false, []attr.Value{termValue("b", "between", "1")}, []attr.Value{}),
+			}),
+			`groups[0].terms[0]: the "between" operator requires exactly two values, the lower and upper bounds`,
+		},
+	}
+
+	for _, tc := range cases {
+		_, err := Compile(ctx, tc.expr)
+		var exprErr *Error
+		if !errors.As(err, &exprErr) {
+			t.Fatalf("Compile() error = %v, want *Error", err)
+		}
+		if exprErr.Error() != tc.want {
+			t.Errorf("Compile() error = %q, want %q", exprErr.Error(), tc.want)
+		}
+	}
+
+	unknown := groupValue(MaxDepth, "", false, []attr.Value{termValue("a", "equals", "b")}, nil)
+	attrs := unknown.Attributes()
+	attrs["operator"] = types.StringUnknown()
+	if _, err := Compile(ctx, types.ObjectValueMust(AttrTypes(), attrs)); !errors.Is(err, ErrUnknown) {
+		t.Errorf("Compile() error = %v, want ErrUnknown", err)
+	}
+}
+
+func TestPreserve(t *testing.T) {
+	ctx := context.Background()
+	queryType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"filter":            types.StringType,
+		"filter_expression": types.ObjectType{AttrTypes: AttrTypes()},
+	}}
+	stateType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"queries": types.ListType{ElemType: queryType},
+	}}
+	state := func(queries ...attr.Value) tftypes.Value {
+		v, err := types.ObjectValueMust(stateType.AttrTypes, map[string]attr.Value{
+			"queries": types.ListValueMust(queryType, queries),
+		}).ToTerraformValue(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+	queryValue := func(filter types.String, expr types.Object) attr.Value {
+		return types.ObjectValueMust(queryType.AttrTypes, map[string]attr.Value{"filter": filter, "filter_expression": expr})
+	}
+
+	expr := groupValue(MaxDepth, "", false, []attr.Value{termValue("service", "equals", "api")}, nil)
+	prior := state(
+		queryValue(types.StringNull(), expr),
+		queryValue(types.StringValue("env:prod"), Null()),
+		queryValue(types.StringNull(), expr),
+	)
+	current := state(
+		queryValue(types.StringValue(`service:"api"`), Null()),
+		queryValue(types.StringValue("env:prod"), Null()),
+		queryValue(types.StringValue(`service:"web"`), Null()),
+	)
+
+	got, err := Preserve(prior, current)
+	if err != nil {
+		t.Fatalf("Preserve() returned error: %s", err)
+	}
+	want := state(
+		queryValue(types.StringNull(), expr),
+		queryValue(types.StringValue("env:prod"), Null()),
+		queryValue(types.StringValue(`service:"web"`), Null()),
+	)
+	if !got.Equal(want) {
+		t.Errorf("Preserve() = %s, want %s", got, want)
+	}
+}
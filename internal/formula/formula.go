@@ -0,0 +1,260 @@
+// Package formula parses the arithmetic formulas that combine the queries of monitors,
+// SLOs and dashboard visualizations. Queries are referenced by position as q1, q2, …
+// in the order they are declared, and combined with numbers, + - * / and parentheses:
+//
+//	(q1 / (q1 + q2)) * 100
+//
+// Other identifiers, such as formula aliases, are collected for the caller to check.
+package formula
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// SyntaxError reports a problem in a formula. Pos is the byte offset of the offending
+// token and Column its position in characters, starting at 1.
+type SyntaxError struct {
+	Pos    int
+	Column int
+	Msg    string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("column %d: %s", e.Column, e.Msg)
+}
+
+// Formula is a parsed formula.
+type Formula struct {
+	// References lists the query numbers the formula uses, ascending and without
+	// duplicates: q2 / q1 gives [1 2].
+	References []int
+	// Identifiers lists the other identifiers the formula uses, such as formula
+	// aliases, sorted and without duplicates.
+	Identifiers []string
+}
+
+// Parse checks the syntax of a formula and collects the queries it references. Errors
+// are returned as *SyntaxError.
+func Parse(src string) (*Formula, error) {
+	p := &parser{src: src, refs: map[int]bool{}, idents: map[string]bool{}}
+	p.next()
+	if p.tok.kind == tokEOF {
+		return nil, p.errorf(p.tok, "formula is empty")
+	}
+	if err := p.parseExpr(); err != nil {
+		return nil, err
+	}
+	if p.tok.kind != tokEOF {
+		return nil, p.unexpected()
+	}
+
+	f := &Formula{}
+	for n := range p.refs {
+		f.References = append(f.References, n)
+	}
+	sort.Ints(f.References)
+	for ident := range p.idents {
+		f.Identifiers = append(f.Identifiers, ident)
+	}
+	sort.Strings(f.Identifiers)
+	return f, nil
+}
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokNumber
+	tokRef
+	tokOperator
+	tokLParen
+	tokRParen
+	tokInvalid
+)
+
+type token struct {
+	kind tokenKind
+	pos  int
+	text string
+	ref  int
+}
+
+type parser struct {
+	src    string
+	off    int
+	tok    token
+	refs   map[int]bool
+	idents map[string]bool
+}
+
+// next advances to the following token.
+func (p *parser) next() {
+	for p.off < len(p.src) && isSpace(p.src[p.off]) {
+		p.off++
+	}
+	start := p.off
+	if start == len(p.src) {
+		p.tok = token{kind: tokEOF, pos: start}
+		return
+	}
+
+	c := p.src[start]
+	switch {
+	case c == '(':
+		p.off++
+		p.tok = token{kind: tokLParen, pos: start, text: "("}
+	case c == ')':
+		p.off++
+		p.tok = token{kind: tokRParen, pos: start, text: ")"}
+	case c == '+' || c == '-' || c == '*' || c == '/':
+		p.off++
+		p.tok = token{kind: tokOperator, pos: start, text: string(c)}
+	case isDigit(c) || c == '.':
+		for p.off < len(p.src) && (isDigit(p.src[p.off]) || p.src[p.off] == '.') {
+			p.off++
+		}
+		// Exponent, as in 1e6 or 2.5E-3
+		if p.off < len(p.src) && (p.src[p.off] == 'e' || p.src[p.off] == 'E') {
+			end := p.off + 1
+			if end < len(p.src) && (p.src[end] == '+' || p.src[end] == '-') {
+				end++
+			}
+			if end < len(p.src) && isDigit(p.src[end]) {
+				for end < len(p.src) && isDigit(p.src[end]) {
+					end++
+				}
+				p.off = end
+			}
+		}
+		p.tok = token{kind: tokNumber, pos: start, text: p.src[start:p.off]}
+	case isLetter(c):
+		for p.off < len(p.src) && (isLetter(p.src[p.off]) || isDigit(p.src[p.off])) {
+			p.off++
+		}
+		p.tok = token{kind: tokRef, pos: start, text: p.src[start:p.off]}
+	default:
+		_, size := utf8.DecodeRuneInString(p.src[start:])
+		p.off += size
+		p.tok = token{kind: tokInvalid, pos: start, text: p.src[start:p.off]}
+	}
+}
+
+// parseExpr parses a sum: terms joined by + and -.
+func (p *parser) parseExpr() error {
+	if err := p.parseTerm(); err != nil {
+		return err
+	}
+	for p.tok.kind == tokOperator && (p.tok.text == "+" || p.tok.text == "-") {
+		p.next()
+		if err := p.parseTerm(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseTerm parses a product: factors joined by * and /.
+func (p *parser) parseTerm() error {
+	if err := p.parseFactor(); err != nil {
+		return err
+	}
+	for p.tok.kind == tokOperator && (p.tok.text == "*" || p.tok.text == "/") {
+		p.next()
+		if err := p.parseFactor(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *parser) parseFactor() error {
+	tok := p.tok
+	switch tok.kind {
+	case tokOperator:
+		if tok.text != "-" && tok.text != "+" {
+			return p.unexpected()
+		}
+		p.next()
+		return p.parseFactor()
+	case tokNumber:
+		if _, err := strconv.ParseFloat(tok.text, 64); err != nil {
+			return p.errorf(tok, "invalid number %q", tok.text)
+		}
+		p.next()
+		return nil
+	case tokRef:
+		n, ok := queryNumber(tok.text)
+		switch {
+		case ok:
+			p.refs[n] = true
+		case len(tok.text) > 1 && tok.text[0] == 'q' && strings.TrimLeft(tok.text[1:], "0123456789") == "":
+			// q0, or a number too large
+			return p.errorf(tok, "unknown identifier %q, queries are referenced as q1, q2, …", tok.text)
+		default:
+			p.idents[tok.text] = true
+		}
+		p.next()
+		return nil
+	case tokLParen:
+		p.next()
+		if err := p.parseExpr(); err != nil {
+			return err
+		}
+		if p.tok.kind != tokRParen {
+			if p.tok.kind == tokEOF {
+				return p.errorf(tok, "unclosed \"(\"")
+			}
+			return p.unexpected()
+		}
+		p.next()
+		return nil
+	default:
+		return p.unexpected()
+	}
+}
+
+// queryNumber returns N for a qN reference. Numbering starts at 1.
+func queryNumber(ident string) (int, bool) {
+	if len(ident) < 2 || ident[0] != 'q' {
+		return 0, false
+	}
+	for i := 1; i < len(ident); i++ {
+		if !isDigit(ident[i]) {
+			return 0, false
+		}
+	}
+	n, err := strconv.Atoi(ident[1:])
+	if err != nil || n < 1 {
+		return 0, false
+	}
+	return n, true
+}
+
+func (p *parser) unexpected() error {
+	switch p.tok.kind {
+	case tokEOF:
+		return p.errorf(p.tok, "unexpected end of formula")
+	case tokRParen:
+		return p.errorf(p.tok, "unexpected \")\" without a matching \"(\"")
+	case tokInvalid:
+		return p.errorf(p.tok, "invalid character %q", p.tok.text)
+	default:
+		return p.errorf(p.tok, "unexpected %q", p.tok.text)
+	}
+}
+
+func (p *parser) errorf(tok token, format string, args ...any) error {
+	return &SyntaxError{
+		Pos:    tok.pos,
+		Column: utf8.RuneCountInString(p.src[:tok.pos]) + 1,
+		Msg:    fmt.Sprintf(format, args...),
+	}
+}
+
+func isSpace(c byte) bool  { return c == ' ' || c == '\t' || c == '\n' || c == '\r' }
+func isDigit(c byte) bool  { return c >= '0' && c <= '9' }
+func isLetter(c byte) bool { return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_' }
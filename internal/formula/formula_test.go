@@ -0,0 +1,81 @@
+package formula
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseReferences(t *testing.T) {
+	cases := []struct {
+		formula string
+		want    []int
+	}{
+		{"q1", []int{1}},
+		{"q1 + q2", []int{1, 2}},
+		{"q1 - q2", []int{1, 2}},
+		{"(q1 / (q1 + q2)) * 100", []int{1, 2}},
+		{"((q2 - q1) / q2) * 100", []int{1, 2}},
+		{"-q3 * 1.5e2 + .5", []int{3}},
+		{"q10/q2", []int{2, 10}},
+		{"42", nil},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.formula, func(t *testing.T) {
+			f, err := Parse(tc.formula)
+			if err != nil {
+				t.Fatalf("Parse(%q) returned error: %s", tc.formula, err)
+			}
+			if !reflect.DeepEqual(f.References, tc.want) {
+				t.Errorf("Parse(%q).References = %v, want %v", tc.formula, f.References, tc.want)
+			}
+		})
+	}
+}
+
+func TestParseIdentifiers(t *testing.T) {
+	f, err := Parse("error_rate * 100 / q1 + error_rate + q2x")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if want := []int{1}; !reflect.DeepEqual(f.References, want) {
+		t.Errorf("References = %v, want %v", f.References, want)
+	}
+	if want := []string{"error_rate", "q2x"}; !reflect.DeepEqual(f.Identifiers, want) {
+		t.Errorf("Identifiers = %v, want %v", f.Identifiers, want)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	cases := []struct {
+		formula string
+		column  int
+		msg     string
+	}{
+		{"", 1, "formula is empty"},
+		{"   ", 4, "formula is empty"},
+		{"(q1 + q2", 1, `unclosed "("`},
+		{"q1 + q2)", 8, `unexpected ")" without a matching "("`},
+		{"q1 +", 5, "unexpected end of formula"},
+		{"q1 q2", 4, `unexpected "q2"`},
+		{"q1 * / q2", 6, `unexpected "/"`},
+		{"q0", 1, `unknown identifier "q0", queries are referenced as q1, q2, …`},
+		{"q1 % q2", 4, `invalid character "%"`},
+		{"1.2.3", 1, `invalid number "1.2.3"`},
+		{"()", 2, `unexpected ")" without a matching "("`},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.formula, func(t *testing.T) {
+			_, err := Parse(tc.formula)
+			var syntaxErr *SyntaxError
+			if !errors.As(err, &syntaxErr) {
+				t.Fatalf("Parse(%q) error = %v, want *SyntaxError", tc.formula, err)
+			}
+			if syntaxErr.Column != tc.column || syntaxErr.Msg != tc.msg {
+				t.Errorf("Parse(%q) error = column %d %q, want column %d %q", tc.formula, syntaxErr.Column, syntaxErr.Msg, tc.column, tc.msg)
+			}
+		})
+	}
+}
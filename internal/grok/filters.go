@@ -0,0 +1,106 @@
+package grok
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// filterFunc converts a captured value. It returns false when the value cannot be
+// converted, in which case the field is left out of the extracted object.
+type filterFunc func(string) (any, bool)
+
+type filterSpec struct {
+	args  int
+	build func(args []string) filterFunc
+}
+
+// builtinFilters lists the filters accepted in the third part of a %{PATTERN:name:filter}
+// reference, with the number of arguments each one takes.
+var builtinFilters = map[string]filterSpec{
+	"integer": {build: func([]string) filterFunc {
+		return func(v string) (any, bool) {
+			n, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+			return n, err == nil
+		}
+	}},
+	"number": {build: func([]string) filterFunc {
+		return func(v string) (any, bool) {
+			n, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+			return n, err == nil
+		}
+	}},
+	"boolean": {build: func([]string) filterFunc {
+		return func(v string) (any, bool) {
+			b, err := strconv.ParseBool(strings.ToLower(strings.TrimSpace(v)))
+			return b, err == nil
+		}
+	}},
+	"lowercase": {build: func([]string) filterFunc {
+		return func(v string) (any, bool) { return strings.ToLower(v), true }
+	}},
+	"uppercase": {build: func([]string) filterFunc {
+		return func(v string) (any, bool) { return strings.ToUpper(v), true }
+	}},
+	"json": {build: func([]string) filterFunc {
+		return func(v string) (any, bool) {
+			var decoded any
+			err := json.Unmarshal([]byte(v), &decoded)
+			return decoded, err == nil
+		}
+	}},
+	"nullIf": {args: 1, build: func(args []string) filterFunc {
+		return func(v string) (any, bool) { return v, v != args[0] }
+	}},
+}
+
+// parseFilter parses a filter expression such as `integer` or `nullIf("-")`.
+func parseFilter(text string, offset int) (filterFunc, error) {
+	name := text
+	var args []string
+	if open := strings.IndexByte(text, '('); open >= 0 {
+		if !strings.HasSuffix(text, ")") {
+			return nil, &CompileError{Type: ErrInvalidSyntax, Offset: offset + open, Detail: fmt.Sprintf("unterminated argument list in filter %q", text)}
+		}
+		name = text[:open]
+		var err error
+		args, err = parseFilterArgs(text[open+1 : len(text)-1])
+		if err != nil {
+			return nil, &CompileError{Type: ErrInvalidFilter, Offset: offset, Name: name, Detail: err.Error()}
+		}
+	}
+
+	spec, ok := builtinFilters[name]
+	if !ok {
+		return nil, &CompileError{Type: ErrUnknownFilter, Offset: offset, Name: name}
+	}
+	if len(args) != spec.args {
+		return nil, &CompileError{
+			Type:   ErrInvalidFilter,
+			Offset: offset,
+			Name:   name,
+			Detail: fmt.Sprintf("expected %d argument(s), got %d", spec.args, len(args)),
+		}
+	}
+
+	return spec.build(args), nil
+}
+
+func parseFilterArgs(text string) ([]string, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, nil
+	}
+	var args []string
+	for _, raw := range strings.Split(text, ",") {
+		arg := strings.TrimSpace(raw)
+		if strings.HasPrefix(arg, `"`) || strings.HasPrefix(arg, "'") {
+			if len(arg) < 2 || arg[len(arg)-1] != arg[0] {
+				return nil, fmt.Errorf("unterminated string argument %s", arg)
+			}
+			arg = arg[1 : len(arg)-1]
+		}
+		args = append(args, arg)
+	}
+	return args, nil
+}
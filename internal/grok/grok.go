@@ -0,0 +1,255 @@
+// Package grok is an offline implementation of the Grok engine used by the log route
+// Grok parser processor. It understands the route rule syntax, %{PATTERN},
+// %{PATTERN:name} and %{PATTERN:name:filter}, resolves patterns against the built-in
+// library, and extracts fields from sample log lines without calling the Tsuga API.
+//
+// Rules are compiled with Go's RE2 engine, so the literal regular expression parts of a
+// rule must stay within RE2 syntax (no lookarounds or backreferences).
+package grok
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Compile error types. They mirror the discriminators returned by the Tsuga API in
+// GROK_RULE_VALIDATION_ERROR details so that offline and server-side errors read the same.
+const (
+	ErrInvalidSyntax  = "invalid-syntax"
+	ErrUnknownPattern = "unknown-pattern"
+	ErrInvalidFilter  = "invalid-filter"
+	ErrUnknownFilter  = "unknown-filter"
+)
+
+// groupPrefix names the regexp groups generated for named references. User supplied
+// inline groups such as (?<status>\d+) never start with it, so they can be told apart.
+const groupPrefix = "_grok"
+
+var (
+	patternNameRe = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+	fieldNameRe   = regexp.MustCompile(`^[A-Za-z0-9_@-]+(?:\.[A-Za-z0-9_@-]+)*$`)
+)
+
+// CompileError describes why a rule could not be compiled. Offset is the byte offset in
+// the rule where the problem was found and Name holds the offending pattern or filter.
+type CompileError struct {
+	Type   string
+	Offset int
+	Name   string
+	Detail string
+}
+
+func (e *CompileError) Error() string {
+	switch e.Type {
+	case ErrUnknownPattern:
+		return fmt.Sprintf("unknown pattern %q at offset %d", e.Name, e.Offset)
+	case ErrUnknownFilter:
+		return fmt.Sprintf("unknown filter %q at offset %d", e.Name, e.Offset)
+	case ErrInvalidFilter:
+		return fmt.Sprintf("invalid filter %q at offset %d: %s", e.Name, e.Offset, e.Detail)
+	default:
+		return fmt.Sprintf("invalid syntax at offset %d: %s", e.Offset, e.Detail)
+	}
+}
+
+// Rule is a compiled Grok rule.
+type Rule struct {
+	re     *regexp.Regexp
+	fields []field
+}
+
+type field struct {
+	index  int
+	name   string
+	filter filterFunc
+}
+
+type reference struct {
+	pattern string
+	name    string
+	filter  filterFunc
+}
+
+// Compile parses a route Grok rule and expands its pattern references into a regular
+// expression. Errors are returned as *CompileError.
+func Compile(rule string) (*Rule, error) {
+	type capture struct {
+		group  string
+		name   string
+		filter filterFunc
+	}
+	var captures []capture
+
+	expanded, err := substitute(rule, func(ref reference, offset int) (string, error) {
+		body, err := expandPattern(ref.pattern, offset, nil)
+		if err != nil {
+			return "", err
+		}
+		if ref.name == "" {
+			return "(?:" + body + ")", nil
+		}
+		group := fmt.Sprintf("%s%d", groupPrefix, len(captures))
+		captures = append(captures, capture{group: group, name: ref.name, filter: ref.filter})
+		return "(?P<" + group + ">" + body + ")", nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	re, err := regexp.Compile(expanded)
+	if err != nil {
+		return nil, &CompileError{Type: ErrInvalidSyntax, Offset: 0, Detail: err.Error()}
+	}
+
+	compiled := &Rule{re: re}
+	for _, c := range captures {
+		compiled.fields = append(compiled.fields, field{index: re.SubexpIndex(c.group), name: c.name, filter: c.filter})
+	}
+	// Inline named groups written directly in the rule are extracted as plain strings.
+	for i, name := range re.SubexpNames() {
+		if name != "" && !strings.HasPrefix(name, groupPrefix) {
+			compiled.fields = append(compiled.fields, field{index: i, name: name})
+		}
+	}
+
+	return compiled, nil
+}
+
+// Match runs the rule against sample. The rule may match anywhere in the sample, like the
+// route processor. Dotted capture names are expanded into nested objects.
+func (r *Rule) Match(sample string) (map[string]any, bool) {
+	loc := r.re.FindStringSubmatchIndex(sample)
+	if loc == nil {
+		return nil, false
+	}
+
+	extracted := map[string]any{}
+	for _, f := range r.fields {
+		start, end := loc[2*f.index], loc[2*f.index+1]
+		if start < 0 {
+			continue
+		}
+		var value any = sample[start:end]
+		if f.filter != nil {
+			v, ok := f.filter(sample[start:end])
+			if !ok {
+				continue
+			}
+			value = v
+		}
+		setField(extracted, f.name, value)
+	}
+
+	return extracted, true
+}
+
+// MatchFirst tries the rules in order and returns the fields extracted by the first one
+// that matches sample, mirroring the route Grok processor semantics.
+func MatchFirst(rules []*Rule, sample string) (map[string]any, bool) {
+	for _, r := range rules {
+		if extracted, ok := r.Match(sample); ok {
+			return extracted, true
+		}
+	}
+	return nil, false
+}
+
+// substitute replaces every %{...} reference in src with the value returned by fn.
+func substitute(src string, fn func(ref reference, offset int) (string, error)) (string, error) {
+	var b strings.Builder
+	i := 0
+	for {
+		start := strings.Index(src[i:], "%{")
+		if start < 0 {
+			b.WriteString(src[i:])
+			return b.String(), nil
+		}
+		start += i
+		b.WriteString(src[i:start])
+
+		end := strings.IndexByte(src[start:], '}')
+		if end < 0 {
+			return "", &CompileError{Type: ErrInvalidSyntax, Offset: start, Detail: "unterminated pattern reference"}
+		}
+		end += start
+
+		ref, err := parseReference(src[start+2:end], start)
+		if err != nil {
+			return "", err
+		}
+		replacement, err := fn(ref, start)
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(replacement)
+		i = end + 1
+	}
+}
+
+// parseReference parses the body of a %{PATTERN:name:filter} reference.
+func parseReference(body string, offset int) (reference, error) {
+	parts := strings.SplitN(body, ":", 3)
+
+	ref := reference{pattern: parts[0]}
+	if !patternNameRe.MatchString(ref.pattern) {
+		return ref, &CompileError{Type: ErrInvalidSyntax, Offset: offset, Detail: fmt.Sprintf("invalid pattern name %q", ref.pattern)}
+	}
+
+	if len(parts) > 1 {
+		ref.name = parts[1]
+		if !fieldNameRe.MatchString(ref.name) {
+			return ref, &CompileError{Type: ErrInvalidSyntax, Offset: offset, Detail: fmt.Sprintf("invalid capture name %q", ref.name)}
+		}
+	}
+
+	if len(parts) > 2 {
+		filterOffset := offset + 2 + len(parts[0]) + 1 + len(parts[1]) + 1
+		f, err := parseFilter(parts[2], filterOffset)
+		if err != nil {
+			return ref, err
+		}
+		ref.filter = f
+	}
+
+	return ref, nil
+}
+
+// expandPattern resolves a library pattern, recursively expanding the references in its
+// definition. visiting guards against reference cycles.
+func expandPattern(name string, offset int, visiting map[string]bool) (string, error) {
+	def, ok := builtinPatterns[name]
+	if !ok {
+		return "", &CompileError{Type: ErrUnknownPattern, Offset: offset, Name: name}
+	}
+	if visiting[name] {
+		return "", &CompileError{Type: ErrInvalidSyntax, Offset: offset, Detail: fmt.Sprintf("pattern %q references itself", name)}
+	}
+
+	nested := map[string]bool{name: true}
+	for k := range visiting {
+		nested[k] = true
+	}
+
+	return substitute(def, func(ref reference, _ int) (string, error) {
+		body, err := expandPattern(ref.pattern, offset, nested)
+		if err != nil {
+			return "", err
+		}
+		return "(?:" + body + ")", nil
+	})
+}
+
+// setField stores value under a possibly dotted name, creating intermediate objects.
+func setField(target map[string]any, name string, value any) {
+	segments := strings.Split(name, ".")
+	for _, segment := range segments[:len(segments)-1] {
+		next, ok := target[segment].(map[string]any)
+		if !ok {
+			next = map[string]any{}
+			target[segment] = next
+		}
+		target = next
+	}
+	target[segments[len(segments)-1]] = value
+}
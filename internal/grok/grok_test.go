@@ -0,0 +1,120 @@
+package grok
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestBuiltinPatternsCompile(t *testing.T) {
+	for name := range builtinPatterns {
+		if _, err := Compile("%{" + name + ":value}"); err != nil {
+			t.Errorf("pattern %s does not compile: %v", name, err)
+		}
+	}
+}
+
+func TestMatch_APIExample(t *testing.T) {
+	rule, err := Compile(`pod:%{DATA:pod_namespace}/%{DATA:pod_name}\] "%{DATA:request}" HTTP/%{POSINT:status}`)
+	if err != nil {
+		t.Fatalf("unexpected compile error: %v", err)
+	}
+
+	extracted, ok := rule.Match(`[conn-id:abc pod:tsuga-events/tsuga-events-searcher-0] "/token" HTTP/200`)
+	if !ok {
+		t.Fatalf("expected rule to match")
+	}
+	want := map[string]any{
+		"pod_namespace": "tsuga-events",
+		"pod_name":      "tsuga-events-searcher-0",
+		"request":       "/token",
+		"status":        "200",
+	}
+	if !reflect.DeepEqual(extracted, want) {
+		t.Fatalf("unexpected extraction: got %#v, want %#v", extracted, want)
+	}
+}
+
+func TestMatch_FiltersAndNestedNames(t *testing.T) {
+	rule, err := Compile(`%{IPV4:network.client.ip} %{WORD:http.method:uppercase} %{INT:http.status_code:integer} %{NUMBER:duration:number} %{NOTSPACE:user:nullIf("-")}`)
+	if err != nil {
+		t.Fatalf("unexpected compile error: %v", err)
+	}
+
+	extracted, ok := rule.Match("10.0.0.1 get 503 0.25 -")
+	if !ok {
+		t.Fatalf("expected rule to match")
+	}
+	want := map[string]any{
+		"network":  map[string]any{"client": map[string]any{"ip": "10.0.0.1"}},
+		"http":     map[string]any{"method": "GET", "status_code": int64(503)},
+		"duration": 0.25,
+	}
+	if !reflect.DeepEqual(extracted, want) {
+		t.Fatalf("unexpected extraction: got %#v, want %#v", extracted, want)
+	}
+}
+
+func TestMatch_UnnamedReferencesAreNotCaptured(t *testing.T) {
+	rule, err := Compile(`%{TIMESTAMP_ISO8601} %{LOGLEVEL:level} %{GREEDYDATA:message}`)
+	if err != nil {
+		t.Fatalf("unexpected compile error: %v", err)
+	}
+
+	extracted, ok := rule.Match("2026-08-13T10:00:00Z WARN disk almost full")
+	if !ok {
+		t.Fatalf("expected rule to match")
+	}
+	want := map[string]any{"level": "WARN", "message": "disk almost full"}
+	if !reflect.DeepEqual(extracted, want) {
+		t.Fatalf("unexpected extraction: got %#v, want %#v", extracted, want)
+	}
+}
+
+func TestMatchFirst(t *testing.T) {
+	var rules []*Rule
+	for _, src := range []string{`^user=%{USERNAME:user}$`, `^id=%{UUID:id}$`} {
+		r, err := Compile(src)
+		if err != nil {
+			t.Fatalf("unexpected compile error for %q: %v", src, err)
+		}
+		rules = append(rules, r)
+	}
+
+	if extracted, ok := MatchFirst(rules, "id=123e4567-e89b-12d3-a456-426614174000"); !ok || extracted["id"] != "123e4567-e89b-12d3-a456-426614174000" {
+		t.Fatalf("expected second rule to match, got %#v (matched=%v)", extracted, ok)
+	}
+	if _, ok := MatchFirst(rules, "something else"); ok {
+		t.Fatalf("expected no rule to match")
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	cases := []struct {
+		rule     string
+		wantType string
+		wantName string
+		offset   int
+	}{
+		{"%{NOPE:x}", ErrUnknownPattern, "NOPE", 0},
+		{"a %{INT:x:hex}", ErrUnknownFilter, "hex", 10},
+		{"%{INT:x:nullIf}", ErrInvalidFilter, "nullIf", 8},
+		{`%{INT:x:integer("a")}`, ErrInvalidFilter, "integer", 8},
+		{"abc %{INT:x", ErrInvalidSyntax, "", 4},
+		{"%{INT:bad name}", ErrInvalidSyntax, "", 0},
+		{"%{INT:x} (unclosed", ErrInvalidSyntax, "", 0},
+	}
+
+	for _, tc := range cases {
+		_, err := Compile(tc.rule)
+		var compileErr *CompileError
+		if !errors.As(err, &compileErr) {
+			t.Errorf("Compile(%q): expected *CompileError, got %v", tc.rule, err)
+			continue
+		}
+		if compileErr.Type != tc.wantType || compileErr.Name != tc.wantName || compileErr.Offset != tc.offset {
+			t.Errorf("Compile(%q): got type=%s name=%q offset=%d, want type=%s name=%q offset=%d",
+				tc.rule, compileErr.Type, compileErr.Name, compileErr.Offset, tc.wantType, tc.wantName, tc.offset)
+		}
+	}
+}
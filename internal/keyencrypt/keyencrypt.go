@@ -0,0 +1,144 @@
+// Package keyencrypt encrypts secrets returned by the API to a public key supplied in
+// the configuration, so that only the holder of the matching private key can read
+// them back from state. Two kinds of keys are supported:
+//
+//   - PGP public keys, ASCII-armored, base64-encoded binary, or a keybase:<username>
+//     reference resolved offline from <username>.asc in a key directory;
+//   - age X25519 recipients (age1…).
+//
+// Ciphertexts are base64-encoded binary messages, decrypted with
+//
+//	base64 --decode | gpg --decrypt
+//	base64 --decode | age --decrypt -i key.txt
+package keyencrypt
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"filippo.io/age"
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+)
+
+// KeybasePrefix marks a PGP key given as a keybase-style username reference.
+const KeybasePrefix = "keybase:"
+
+// Recipient encrypts secrets to a public key.
+type Recipient interface {
+	// Encrypt returns the base64-encoded ciphertext of plaintext.
+	Encrypt(plaintext string) (string, error)
+	// Fingerprint identifies the public key: the hexadecimal fingerprint of a PGP
+	// primary key, or the age recipient itself.
+	Fingerprint() string
+}
+
+// PGP parses a PGP public key. keybaseDir is the directory holding <username>.asc
+// files for keybase:<username> references. Only the first key of a keyring is used.
+func PGP(key, keybaseDir string) (Recipient, error) {
+	key = strings.TrimSpace(key)
+	var data []byte
+	switch {
+	case strings.HasPrefix(key, KeybasePrefix):
+		username := strings.TrimPrefix(key, KeybasePrefix)
+		if username == "" || strings.ContainsAny(username, `/\`) || username == "." || username == ".." {
+			return nil, fmt.Errorf("invalid keybase username %q", username)
+		}
+		path := filepath.Join(keybaseDir, username+".asc")
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("resolving %s: %w", key, err)
+		}
+		data = raw
+	case strings.HasPrefix(key, "-----BEGIN"):
+		data = []byte(key)
+	default:
+		raw, err := base64.StdEncoding.DecodeString(key)
+		if err != nil {
+			return nil, errors.New("expected an ASCII-armored public key, a base64-encoded binary public key or a keybase:<username> reference")
+		}
+		data = raw
+	}
+
+	var entities openpgp.EntityList
+	var err error
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("-----BEGIN")) {
+		entities, err = openpgp.ReadArmoredKeyRing(bytes.NewReader(data))
+	} else {
+		entities, err = openpgp.ReadKeyRing(bytes.NewReader(data))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading PGP public key: %w", err)
+	}
+	if len(entities) == 0 {
+		return nil, errors.New("no PGP public key found")
+	}
+	entity := entities[0]
+	if _, ok := entity.EncryptionKey(time.Now()); !ok {
+		return nil, fmt.Errorf("PGP key %X has no valid encryption key", entity.PrimaryKey.Fingerprint)
+	}
+	return pgpRecipient{entity: entity}, nil
+}
+
+type pgpRecipient struct {
+	entity *openpgp.Entity
+}
+
+func (r pgpRecipient) Encrypt(plaintext string) (string, error) {
+	var buf bytes.Buffer
+	w, err := openpgp.Encrypt(&buf, []*openpgp.Entity{r.entity}, nil, nil, &packet.Config{})
+	if err != nil {
+		return "", fmt.Errorf("encrypting with PGP key %s: %w", r.Fingerprint(), err)
+	}
+	if _, err := io.WriteString(w, plaintext); err != nil {
+		return "", err
+	}
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+func (r pgpRecipient) Fingerprint() string {
+	return strings.ToUpper(hex.EncodeToString(r.entity.PrimaryKey.Fingerprint))
+}
+
+// Age parses an age X25519 recipient.
+func Age(recipient string) (Recipient, error) {
+	r, err := age.ParseX25519Recipient(strings.TrimSpace(recipient))
+	if err != nil {
+		return nil, err
+	}
+	return ageRecipient{recipient: r}, nil
+}
+
+type ageRecipient struct {
+	recipient *age.X25519Recipient
+}
+
+func (r ageRecipient) Encrypt(plaintext string) (string, error) {
+	var buf bytes.Buffer
+	w, err := age.Encrypt(&buf, r.recipient)
+	if err != nil {
+		return "", fmt.Errorf("encrypting to age recipient %s: %w", r.Fingerprint(), err)
+	}
+	if _, err := io.WriteString(w, plaintext); err != nil {
+		return "", err
+	}
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+func (r ageRecipient) Fingerprint() string {
+	return r.recipient.String()
+}
@@ -0,0 +1,143 @@
+package keyencrypt
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"filippo.io/age"
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+)
+
+func newPGPEntity(t *testing.T) *openpgp.Entity {
+	t.Helper()
+	entity, err := openpgp.NewEntity("ops", "", "ops@example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return entity
+}
+
+func armoredPublicKey(t *testing.T, entity *openpgp.Entity) string {
+	t.Helper()
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, openpgp.PublicKeyType, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := entity.Serialize(w); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+func TestPGP(t *testing.T) {
+	entity := newPGPEntity(t)
+	armored := armoredPublicKey(t, entity)
+	var binary bytes.Buffer
+	if err := entity.Serialize(&binary); err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "ops.asc"), []byte(armored), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, key := range map[string]string{
+		"armored": armored,
+		"base64":  base64.StdEncoding.EncodeToString(binary.Bytes()),
+		"keybase": "keybase:ops",
+	} {
+		t.Run(name, func(t *testing.T) {
+			r, err := PGP(key, dir)
+			if err != nil {
+				t.Fatalf("PGP() returned error: %s", err)
+			}
+			if want := strings.ToUpper(hex.EncodeToString(entity.PrimaryKey.Fingerprint)); r.Fingerprint() != want {
+				t.Errorf("Fingerprint() = %s, want %s", r.Fingerprint(), want)
+			}
+
+			ciphertext, err := r.Encrypt("tsg_secret")
+			if err != nil {
+				t.Fatalf("Encrypt() returned error: %s", err)
+			}
+			raw, err := base64.StdEncoding.DecodeString(ciphertext)
+			if err != nil {
+				t.Fatal(err)
+			}
+			md, err := openpgp.ReadMessage(bytes.NewReader(raw), openpgp.EntityList{entity}, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			plaintext, err := io.ReadAll(md.UnverifiedBody)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(plaintext) != "tsg_secret" {
+				t.Errorf("decrypted %q, want %q", plaintext, "tsg_secret")
+			}
+		})
+	}
+}
+
+func TestPGPErrors(t *testing.T) {
+	dir := t.TempDir()
+	cases := map[string]string{
+		"keybase:missing": "resolving keybase:missing",
+		"keybase:../etc":  `invalid keybase username "../etc"`,
+		"not a key!":      "expected an ASCII-armored public key",
+		"-----BEGIN PGP PUBLIC KEY BLOCK-----\n\n-----END PGP PUBLIC KEY BLOCK-----": "no PGP public key found",
+	}
+	for key, want := range cases {
+		if _, err := PGP(key, dir); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("PGP(%q) error = %v, want it to contain %q", key, err, want)
+		}
+	}
+}
+
+func TestAge(t *testing.T) {
+	identity, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := Age(identity.Recipient().String())
+	if err != nil {
+		t.Fatalf("Age() returned error: %s", err)
+	}
+	if r.Fingerprint() != identity.Recipient().String() {
+		t.Errorf("Fingerprint() = %s, want %s", r.Fingerprint(), identity.Recipient())
+	}
+
+	ciphertext, err := r.Encrypt("tsg_secret")
+	if err != nil {
+		t.Fatalf("Encrypt() returned error: %s", err)
+	}
+	raw, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pr, err := age.Decrypt(bytes.NewReader(raw), identity)
+	if err != nil {
+		t.Fatal(err)
+	}
+	plaintext, err := io.ReadAll(pr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(plaintext) != "tsg_secret" {
+		t.Errorf("decrypted %q, want %q", plaintext, "tsg_secret")
+	}
+
+	if _, err := Age("age1invalid"); err == nil {
+		t.Error("Age(\"age1invalid\") returned no error")
+	}
+}
@@ -0,0 +1,36 @@
+// Package planmodifiers holds plan modifiers shared by the hand-written resource
+// schemas.
+package planmodifiers
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// UseNonNullStateForUnknown returns a plan modifier that copies the prior state
+// value of an unconfigured computed string into the plan, like
+// stringplanmodifier.UseStateForUnknown, but only when that value is set. It suits
+// attributes nested in optional objects: when such an object is added to an
+// existing resource its prior value is null, and copying the null would make the
+// value the provider then sets an inconsistent result.
+func UseNonNullStateForUnknown() planmodifier.String {
+	return useNonNullStateForUnknownModifier{}
+}
+
+type useNonNullStateForUnknownModifier struct{}
+
+func (m useNonNullStateForUnknownModifier) Description(_ context.Context) string {
+	return "Once set, the value of this attribute in state will not change."
+}
+
+func (m useNonNullStateForUnknownModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m useNonNullStateForUnknownModifier) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || !req.PlanValue.IsUnknown() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	resp.PlanValue = req.StateValue
+}
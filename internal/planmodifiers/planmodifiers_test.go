@@ -0,0 +1,32 @@
+package planmodifiers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUseNonNullStateForUnknown(t *testing.T) {
+	cases := []struct {
+		name   string
+		state  types.String
+		config types.String
+		plan   types.String
+		want   types.String
+	}{
+		{"prior value", types.StringValue("bar"), types.StringNull(), types.StringUnknown(), types.StringValue("bar")},
+		{"null prior value", types.StringNull(), types.StringNull(), types.StringUnknown(), types.StringUnknown()},
+		{"known plan", types.StringValue("bar"), types.StringValue("pie"), types.StringValue("pie"), types.StringValue("pie")},
+		{"unknown config", types.StringValue("bar"), types.StringUnknown(), types.StringUnknown(), types.StringUnknown()},
+	}
+	for _, tc := range cases {
+		req := planmodifier.StringRequest{StateValue: tc.state, ConfigValue: tc.config, PlanValue: tc.plan}
+		resp := &planmodifier.StringResponse{PlanValue: tc.plan}
+		UseNonNullStateForUnknown().PlanModifyString(context.Background(), req, resp)
+		if !resp.PlanValue.Equal(tc.want) {
+			t.Errorf("%s: planned %s, want %s", tc.name, resp.PlanValue, tc.want)
+		}
+	}
+}
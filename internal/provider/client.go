@@ -7,39 +7,67 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strings"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 )
 
+// defaultRequestTimeout bounds requests made without a deadline, such as those of
+// data sources. Resource operations set their own deadline from `timeouts`.
+const defaultRequestTimeout = 30 * time.Second
+
 type TsugaClient struct {
 	BaseURL string
 	Token   string
 	Version string
 	Commit  string
 	Date    string
-	client  *http.Client
+
+	// ConsistencyTimeout bounds waitForConsistency; zero disables the wait.
+	ConsistencyTimeout time.Duration
+	// ClusterID is the provider's default cluster, sent as the clusterId of
+	// telemetry requests that set none. Empty leaves the API default.
+	ClusterID string
+	// ValidateMetrics enables the plan-time checks of the metrics queries of
+	// monitors and dashboards.
+	ValidateMetrics bool
+
+	client        *http.Client
+	pollInterval  time.Duration
+	metricCatalog metricCatalog
 }
 
 func (c *TsugaClient) httpClient() *http.Client {
 	if c.client == nil {
-		c.client = &http.Client{
-			Timeout: 30 * time.Second,
-		}
+		c.client = &http.Client{}
 	}
 	return c.client
 }
 
+// doRequest sends an API request bound by the deadline of ctx, or by
+// defaultRequestTimeout when ctx has none. POSTs carry the idempotency key of ctx,
+// if any. The caller must close the response body.
 func (c *TsugaClient) doRequest(ctx context.Context, method, path string, body interface{}) (*http.Response, error) {
+	cancel := context.CancelFunc(func() {})
+	if _, ok := ctx.Deadline(); !ok {
+		ctx, cancel = context.WithTimeout(ctx, defaultRequestTimeout)
+	}
+
 	var reqBody io.Reader
 	if body != nil {
 		jsonBody, err := json.Marshal(body)
 		if err != nil {
+			cancel()
 			return nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
 		reqBody = bytes.NewBuffer(jsonBody)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, method, c.BaseURL+path, reqBody)
+	req, err := http.NewRequestWithContext(ctx, method, c.BaseURL+c.withDefaultClusterID(path), reqBody)
 	if err != nil {
+		cancel()
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
@@ -52,15 +80,100 @@ func (c *TsugaClient) doRequest(ctx context.Context, method, path string, body i
 	if body != nil {
 		req.Header.Set("Content-Type", "application/json")
 	}
+	if key := idempotencyKey(ctx); key != "" && method == http.MethodPost {
+		req.Header.Set(idempotencyKeyHeader, key)
+	}
 
 	resp, err := c.httpClient().Do(req)
 	if err != nil {
+		cancel()
 		return nil, fmt.Errorf("failed to execute request: %w", err)
 	}
 
+	// The deadline also covers reading the body, so release it only on Close.
+	resp.Body = cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
 	return resp, nil
 }
 
+// telemetryPaths are the paths of the telemetry APIs taking a clusterId query
+// parameter in multi-cluster organizations. Those ending with a slash match every
+// path under them.
+var telemetryPaths = []string{
+	"/v1/aggregation/",
+	"/v1/kubernetes-explorer/",
+	"/v1/logs/",
+	"/v1/metrics",
+	"/v1/metrics/",
+	"/v1/promql",
+	"/v1/quality-reports/",
+	"/v1/services",
+	"/v1/traces/",
+}
+
+// withDefaultClusterID adds the client's ClusterID to telemetry paths without a
+// clusterId.
+func (c *TsugaClient) withDefaultClusterID(path string) string {
+	if c.ClusterID == "" {
+		return path
+	}
+	rawPath, rawQuery, _ := strings.Cut(path, "?")
+	telemetry := false
+	for _, p := range telemetryPaths {
+		if rawPath == p || (strings.HasSuffix(p, "/") && strings.HasPrefix(rawPath, p)) {
+			telemetry = true
+			break
+		}
+	}
+	if !telemetry {
+		return path
+	}
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil || query.Has("clusterId") {
+		return path
+	}
+	query.Set("clusterId", c.ClusterID)
+	return rawPath + "?" + query.Encode()
+}
+
+// fetchJSON sends an API request and decodes the response into out, reporting
+// failures as diagnostics such as "Unable to list clusters: ...", with operation
+// "list clusters".
+func (c *TsugaClient) fetchJSON(ctx context.Context, method, path string, body interface{}, operation string, out any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	httpResp, err := c.doRequest(ctx, method, path, body)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to %s: %s", operation, err))
+		return diags
+	}
+	defer func() { _ = httpResp.Body.Close() }()
+
+	if err := c.checkResponse(httpResp); err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Unable to %s: %s", operation, err))
+		return diags
+	}
+
+	raw, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		diags.AddError("Parse Error", fmt.Sprintf("Unable to read response body: %s", err))
+		return diags
+	}
+	if err := json.Unmarshal(raw, out); err != nil {
+		diags.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err))
+	}
+	return diags
+}
+
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b cancelOnClose) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
+
 func (c *TsugaClient) checkResponse(resp *http.Response) error {
 	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
 		return nil
@@ -81,3 +81,28 @@ func TestTsugaClientCheckResponse(t *testing.T) {
 		t.Fatalf("expected error response to return error")
 	}
 }
+
+func TestTsugaClientWithDefaultClusterID(t *testing.T) {
+	t.Parallel()
+
+	client := &TsugaClient{ClusterID: "c1"}
+	tests := map[string]string{
+		"/v1/services":                       "/v1/services?clusterId=c1",
+		"/v1/logs/patterns?query=error":      "/v1/logs/patterns?clusterId=c1&query=error",
+		"/v1/metrics?clusterId=c2":           "/v1/metrics?clusterId=c2",
+		"/v1/monitors":                       "/v1/monitors",
+		"/v1/teams/abc":                      "/v1/teams/abc",
+		"/v1/services/abc":                   "/v1/services/abc",
+		"/v1/metrics/http.requests":          "/v1/metrics/http.requests?clusterId=c1",
+		"/v1/aggregation/multi-query/scalar": "/v1/aggregation/multi-query/scalar?clusterId=c1",
+	}
+	for path, want := range tests {
+		if got := client.withDefaultClusterID(path); got != want {
+			t.Errorf("withDefaultClusterID(%q) = %q, want %q", path, got, want)
+		}
+	}
+
+	if got := (&TsugaClient{}).withDefaultClusterID("/v1/services"); got != "/v1/services" {
+		t.Errorf("withDefaultClusterID() without a default cluster = %q, want the path unchanged", got)
+	}
+}
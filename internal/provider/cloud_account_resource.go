@@ -9,6 +9,7 @@ import (
 	"terraform-provider-tsuga/internal/resource_cloud_account"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -49,8 +50,8 @@ func (r *cloudAccountResource) Metadata(_ context.Context, req resource.Metadata
 	resp.TypeName = req.ProviderTypeName + "_cloud_account"
 }
 
-func (r *cloudAccountResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = resource_cloud_account.CloudAccountResourceSchema()
+func (r *cloudAccountResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = withTimeouts(ctx, resource_cloud_account.CloudAccountResourceSchema())
 }
 
 func (r *cloudAccountResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
@@ -94,7 +95,40 @@ func (r *cloudAccountResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	connectionSettings, cloudType, cloudAccountId := expandConnectionSettings(plan)
+
+	if plan.AdoptExisting.ValueBool() {
+		existing, found, diags := r.findCloudAccount(ctx, cloudType, cloudAccountId)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if found {
+			data, diags := r.adoptCloudAccount(ctx, existing, plan.AccountFriendlyName)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			flattenAPIResponse(&plan, data)
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root(cloudType),
+				"Connection Settings Not Applied",
+				fmt.Sprintf("The %s cloud account %s was already connected to Tsuga and has been adopted. The API cannot update connection settings, so the configured %s settings were not applied: they are recorded in state, but the account keeps the settings it was connected with. Delete and reconnect the account to change them.", cloudType, cloudAccountId, cloudType),
+			)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+			return
+		}
+	}
+
 	requestBody := map[string]interface{}{
 		"cloudType":          cloudType,
 		"cloudAccountId":     cloudAccountId,
@@ -126,6 +160,45 @@ func (r *cloudAccountResource) Create(ctx context.Context, req resource.CreateRe
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
+// findCloudAccount looks up the connected cloud account of cloudType with the
+// cloud-native ID cloudAccountId, for `adopt_existing`.
+func (r *cloudAccountResource) findCloudAccount(ctx context.Context, cloudType, cloudAccountId string) (cloudAccountData, bool, diag.Diagnostics) {
+	accounts, diags := listCloudAccounts(ctx, r.client)
+	if diags.HasError() {
+		return cloudAccountData{}, false, diags
+	}
+	for _, account := range accounts {
+		if account.CloudType == cloudType && account.CloudAccountId == cloudAccountId {
+			return account, true, diags
+		}
+	}
+	return cloudAccountData{}, false, diags
+}
+
+// adoptCloudAccount takes over an existing cloud account, setting its friendly
+// name to the configured one, as Update would.
+func (r *cloudAccountResource) adoptCloudAccount(ctx context.Context, existing cloudAccountData, friendlyName types.String) (cloudAccountData, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if existing.AccountFriendlyName == friendlyName.ValueString() {
+		return existing, diags
+	}
+
+	var apiResp cloudAccountAPIResponse
+	apiPath := fmt.Sprintf("%s/%s", cloudAccountBasePath, existing.ID)
+	requestBody := map[string]interface{}{
+		"accountFriendlyName": friendlyName.ValueString(),
+	}
+	diags.Append(r.client.fetchJSON(ctx, http.MethodPut, apiPath, requestBody, "adopt cloud account", &apiResp)...)
+	return apiResp.Data, diags
+}
+
+// listCloudAccounts returns the cloud accounts connected to Tsuga.
+func listCloudAccounts(ctx context.Context, client *TsugaClient) ([]cloudAccountData, diag.Diagnostics) {
+	var apiResp cloudAccountsListAPIResponse
+	diags := client.fetchJSON(ctx, http.MethodGet, cloudAccountBasePath, nil, "list cloud accounts", &apiResp)
+	return apiResp.Data, diags
+}
+
 func (r *cloudAccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state resource_cloud_account.CloudAccountModel
 
@@ -134,6 +207,15 @@ func (r *cloudAccountResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	apiPath := fmt.Sprintf("%s/%s", cloudAccountBasePath, state.Id.ValueString())
 	httpResp, err := r.client.doRequest(ctx, http.MethodGet, apiPath, nil)
 	if err != nil {
@@ -172,6 +254,15 @@ func (r *cloudAccountResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	requestBody := map[string]interface{}{
 		"accountFriendlyName": plan.AccountFriendlyName.ValueString(),
 	}
@@ -207,6 +298,15 @@ func (r *cloudAccountResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	apiPath := fmt.Sprintf("%s/%s", cloudAccountBasePath, state.Id.ValueString())
 	httpResp, err := r.client.doRequest(ctx, http.MethodDelete, apiPath, map[string]interface{}{})
 	if err != nil {
@@ -240,6 +340,10 @@ type cloudAccountAPIResponse struct {
 	Data cloudAccountData `json:"data"`
 }
 
+type cloudAccountsListAPIResponse struct {
+	Data []cloudAccountData `json:"data"`
+}
+
 type cloudAccountData struct {
 	ID                  string `json:"id"`
 	CloudType           string `json:"cloudType"`
@@ -53,3 +53,51 @@ resource "tsuga_cloud_account" "test" {
 		},
 	})
 }
+
+func TestAccCloudAccountResource_AdoptExisting(t *testing.T) {
+	accountId := fmt.Sprintf("1234567%05d", 43)
+	config := func(adopted string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "tsuga_cloud_account" "original" {
+  account_friendly_name = "Original AWS"
+
+  aws = {
+    account_id  = "%s"
+    external_id = "test-external-id"
+    role_arn    = "arn:aws:iam::%s:role/tsuga-inventory"
+  }
+}
+%s
+`, accountId, accountId, adopted)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(""),
+			},
+			// A second resource for the same account takes it over. It keeps the
+			// friendly name, so that the two resources agree.
+			{
+				Config: config(fmt.Sprintf(`
+resource "tsuga_cloud_account" "adopted" {
+  adopt_existing        = true
+  account_friendly_name = "Original AWS"
+
+  aws = {
+    account_id  = "%s"
+    external_id = "test-external-id"
+    role_arn    = "arn:aws:iam::%s:role/tsuga-inventory"
+  }
+
+  depends_on = [tsuga_cloud_account.original]
+}
+`, accountId, accountId)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("tsuga_cloud_account.adopted", "id", "tsuga_cloud_account.original", "id"),
+				),
+			},
+		},
+	})
+}
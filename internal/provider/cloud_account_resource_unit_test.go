@@ -1,6 +1,10 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"terraform-provider-tsuga/internal/resource_cloud_account"
@@ -49,3 +53,42 @@ func TestBuildConnectionSettings_Gcp(t *testing.T) {
 		t.Fatalf("unexpected settings: %#v", settings)
 	}
 }
+
+func TestFindAndAdoptCloudAccount(t *testing.T) {
+	t.Parallel()
+
+	var renamed string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == cloudAccountBasePath:
+			_, _ = w.Write([]byte(`{"data":[{"id":"ca1","cloudType":"gcp","cloudAccountId":"123456789012"},{"id":"ca2","cloudType":"aws","cloudAccountId":"123456789012","accountFriendlyName":"Old"}]}`))
+		case r.Method == http.MethodPut && r.URL.Path == cloudAccountBasePath+"/ca2":
+			var body map[string]string
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			renamed = body["accountFriendlyName"]
+			_, _ = w.Write([]byte(`{"data":{"id":"ca2","cloudType":"aws","cloudAccountId":"123456789012","accountFriendlyName":"` + renamed + `"}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+	r := &cloudAccountResource{client: &TsugaClient{BaseURL: server.URL, client: server.Client()}}
+
+	existing, found, diags := r.findCloudAccount(context.Background(), "aws", "123456789012")
+	if diags.HasError() || !found || existing.ID != "ca2" {
+		t.Fatalf("findCloudAccount() = %v, %v, %v, want ca2", existing, found, diags)
+	}
+	if _, found, _ := r.findCloudAccount(context.Background(), "aws", "999999999999"); found {
+		t.Errorf("findCloudAccount() found an account for another ID")
+	}
+
+	data, diags := r.adoptCloudAccount(context.Background(), existing, types.StringValue("New"))
+	if diags.HasError() || renamed != "New" || data.AccountFriendlyName != "New" {
+		t.Errorf("adoptCloudAccount() = %v, %v, renamed to %q, want the account renamed New", data, diags, renamed)
+	}
+
+	renamed = ""
+	if _, diags := r.adoptCloudAccount(context.Background(), data, types.StringValue("New")); diags.HasError() || renamed != "" {
+		t.Errorf("adoptCloudAccount() with the same name sent an update")
+	}
+}
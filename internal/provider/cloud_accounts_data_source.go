@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-tsuga/internal/datasource_cloud_accounts"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*cloudAccountsDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*cloudAccountsDataSource)(nil)
+
+func NewCloudAccountsDataSource() datasource.DataSource {
+	return &cloudAccountsDataSource{}
+}
+
+type cloudAccountsDataSource struct {
+	client *TsugaClient
+}
+
+func (d *cloudAccountsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*TsugaClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *TsugaClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *cloudAccountsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloud_accounts"
+}
+
+func (d *cloudAccountsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_cloud_accounts.CloudAccountsDataSourceSchema(ctx)
+}
+
+func (d *cloudAccountsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config datasource_cloud_accounts.CloudAccountsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accounts, diags := listCloudAccounts(ctx, d.client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items := []attr.Value{}
+	ids := []string{}
+	for _, a := range accounts {
+		if !config.CloudType.IsNull() && a.CloudType != config.CloudType.ValueString() {
+			continue
+		}
+		items = append(items, types.ObjectValueMust(datasource_cloud_accounts.CloudAccountAttrTypes(), map[string]attr.Value{
+			"id":                    types.StringValue(a.ID),
+			"cloud_type":            types.StringValue(a.CloudType),
+			"cloud_account_id":      types.StringValue(a.CloudAccountId),
+			"account_friendly_name": stringValueOrNull(a.AccountFriendlyName),
+		}))
+		ids = append(ids, a.ID)
+	}
+
+	accountList, diags := types.ListValue(types.ObjectType{AttrTypes: datasource_cloud_accounts.CloudAccountAttrTypes()}, items)
+	resp.Diagnostics.Append(diags...)
+	idList, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.CloudAccounts = accountList
+	config.Ids = idList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
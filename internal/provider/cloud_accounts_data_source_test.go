@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCloudAccountsDataSource(t *testing.T) {
+	accountId := fmt.Sprintf("1234567%05d", 44)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "tsuga_cloud_account" "test" {
+  account_friendly_name = "Listed AWS"
+
+  aws = {
+    account_id  = "%s"
+    external_id = "test-external-id"
+    role_arn    = "arn:aws:iam::%s:role/tsuga-inventory"
+  }
+}
+
+data "tsuga_cloud_accounts" "aws" {
+  cloud_type = "aws"
+
+  depends_on = [tsuga_cloud_account.test]
+}
+`, accountId, accountId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.tsuga_cloud_accounts.aws", "cloud_accounts.*", map[string]string{
+						"cloud_type":            "aws",
+						"cloud_account_id":      accountId,
+						"account_friendly_name": "Listed AWS",
+					}),
+					resource.TestCheckTypeSetElemAttrPair("data.tsuga_cloud_accounts.aws", "ids.*", "tsuga_cloud_account.test", "id"),
+				),
+			},
+		},
+	})
+}
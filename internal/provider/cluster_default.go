@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// planDefaultClusterIDs plans the provider's `cluster_id` as the `cluster_ids` of
+// a resource whose configuration omits them. Without it, the attribute stays
+// computed and the API applies to all clusters.
+func planDefaultClusterIDs(ctx context.Context, client *TsugaClient, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if client == nil || client.ClusterID == "" || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var configured types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("cluster_ids"), &configured)...)
+	if resp.Diagnostics.HasError() || !configured.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("cluster_ids"), []string{client.ClusterID})...)
+}
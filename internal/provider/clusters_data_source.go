@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"terraform-provider-tsuga/internal/datasource_clusters"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*clustersDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*clustersDataSource)(nil)
+
+func NewClustersDataSource() datasource.DataSource {
+	return &clustersDataSource{}
+}
+
+type clustersDataSource struct {
+	client *TsugaClient
+}
+
+func (d *clustersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*TsugaClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *TsugaClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *clustersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_clusters"
+}
+
+func (d *clustersDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_clusters.ClustersDataSourceSchema(ctx)
+}
+
+func (d *clustersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config datasource_clusters.ClustersModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiResp clustersListAPIResponse
+	resp.Diagnostics.Append(d.client.fetchJSON(ctx, http.MethodGet, "/v1/clusters", nil, "list clusters", &apiResp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusters := []attr.Value{}
+	ids := []string{}
+	for _, c := range apiResp.Data {
+		if !config.Region.IsNull() && c.ClusterRegion != config.Region.ValueString() {
+			continue
+		}
+		clusters = append(clusters, types.ObjectValueMust(datasource_clusters.ClusterAttrTypes(), map[string]attr.Value{
+			"id":            types.StringValue(c.ID),
+			"name":          types.StringValue(c.Name),
+			"friendly_name": stringValueOrNull(c.FriendlyName),
+			"type":          types.StringValue(c.ClusterType),
+			"region":        types.StringValue(c.ClusterRegion),
+		}))
+		ids = append(ids, c.ID)
+	}
+
+	clusterList, diags := types.ListValue(types.ObjectType{AttrTypes: datasource_clusters.ClusterAttrTypes()}, clusters)
+	resp.Diagnostics.Append(diags...)
+	idList, diags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.Clusters = clusterList
+	config.Ids = idList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+type clustersListAPIResponse struct {
+	Data []struct {
+		ID            string `json:"id"`
+		Name          string `json:"name"`
+		FriendlyName  string `json:"friendlyName"`
+		ClusterType   string `json:"clusterType"`
+		ClusterRegion string `json:"clusterRegion"`
+	} `json:"data"`
+}
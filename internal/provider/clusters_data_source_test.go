@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccClustersDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "tsuga_clusters" "all" {}
+
+data "tsuga_clusters" "none" {
+  region = "no-such-region"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.tsuga_clusters.all", "clusters.0.id"),
+					resource.TestCheckResourceAttrSet("data.tsuga_clusters.all", "clusters.0.region"),
+					resource.TestCheckResourceAttrPair("data.tsuga_clusters.all", "ids.0", "data.tsuga_clusters.all", "clusters.0.id"),
+					resource.TestCheckResourceAttr("data.tsuga_clusters.none", "clusters.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// defaultConsistencyTimeout is the provider's `consistency_timeout` when unset.
+const defaultConsistencyTimeout = 2 * time.Minute
+
+// Poll intervals of waitForConsistency, doubling from the first to the last.
+const (
+	consistencyMinPollInterval = 250 * time.Millisecond
+	consistencyMaxPollInterval = 5 * time.Second
+)
+
+// waitForConsistency polls GET path after a write until match reports that the
+// response reflects it. The API acknowledges writes before every replica serves
+// them, so an object read right after its creation may be missing or stale, and
+// objects referencing it rejected with "not found". A 404 is retried like a
+// mismatch; any other error response is returned as is.
+//
+// The wait ends after the client's ConsistencyTimeout, or when ctx is done,
+// whichever comes first. A zero ConsistencyTimeout disables it.
+func (c *TsugaClient) waitForConsistency(ctx context.Context, path string, match func(body []byte) (bool, error)) error {
+	timeout := c.ConsistencyTimeout
+	if timeout <= 0 {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	interval := c.pollInterval
+	if interval == 0 {
+		interval = consistencyMinPollInterval
+	}
+
+	lastStatus := "no response"
+	for {
+		ok, status, err := c.pollConsistency(ctx, path, match)
+		if ok {
+			return nil
+		}
+		if err != nil && !errors.Is(err, context.DeadlineExceeded) {
+			return err
+		}
+		if status != "" {
+			lastStatus = status
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("GET %s did not reflect the change in time (last response: %s)", path, lastStatus)
+		case <-timer.C:
+		}
+		interval = min(interval*2, consistencyMaxPollInterval)
+	}
+}
+
+// pollConsistency makes one request of waitForConsistency. It returns whether the
+// response matched, and otherwise a short description of it.
+func (c *TsugaClient) pollConsistency(ctx context.Context, path string, match func(body []byte) (bool, error)) (bool, string, error) {
+	httpResp, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		if ctx.Err() != nil {
+			return false, "", context.DeadlineExceeded
+		}
+		return false, "", err
+	}
+	defer func() { _ = httpResp.Body.Close() }()
+
+	if httpResp.StatusCode == http.StatusNotFound {
+		return false, "404 Not Found", nil
+	}
+	if err := c.checkResponse(httpResp); err != nil {
+		return false, "", err
+	}
+
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return false, "", fmt.Errorf("unable to read response body: %w", err)
+	}
+	ok, err := match(body)
+	if err != nil {
+		return false, "", fmt.Errorf("unable to parse response: %w", err)
+	}
+	return ok, "stale object", nil
+}
+
+// anyObject is a waitForConsistency match accepting any successful response, for
+// waiting until an object is visible at all.
+func anyObject([]byte) (bool, error) {
+	return true, nil
+}
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWaitForConsistency(t *testing.T) {
+	t.Parallel()
+
+	// The object is missing, then stale, then as written.
+	responses := []struct {
+		status int
+		body   string
+	}{
+		{http.StatusNotFound, `{}`},
+		{http.StatusOK, `{"data":{"name":"old"}}`},
+		{http.StatusOK, `{"data":{"name":"new"}}`},
+	}
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		response := responses[min(requests, len(responses)-1)]
+		requests++
+		w.WriteHeader(response.status)
+		_, _ = w.Write([]byte(response.body))
+	}))
+	defer server.Close()
+
+	client := &TsugaClient{
+		BaseURL:            server.URL,
+		ConsistencyTimeout: time.Minute,
+		client:             server.Client(),
+		pollInterval:       time.Millisecond,
+	}
+	match := func(body []byte) (bool, error) {
+		return strings.Contains(string(body), `"new"`), nil
+	}
+
+	if err := client.waitForConsistency(context.Background(), "/v1/teams/t1", match); err != nil {
+		t.Fatalf("waitForConsistency() error = %v", err)
+	}
+	if requests != 3 {
+		t.Errorf("waitForConsistency() made %d requests, want 3", requests)
+	}
+}
+
+func TestWaitForConsistency_Timeout(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := &TsugaClient{
+		BaseURL:            server.URL,
+		ConsistencyTimeout: 50 * time.Millisecond,
+		client:             server.Client(),
+		pollInterval:       time.Millisecond,
+	}
+
+	err := client.waitForConsistency(context.Background(), "/v1/teams/t1", anyObject)
+	if err == nil || !strings.Contains(err.Error(), "404 Not Found") {
+		t.Fatalf("waitForConsistency() error = %v, want a timeout reporting the last 404", err)
+	}
+}
+
+func TestWaitForConsistency_ErrorResponse(t *testing.T) {
+	t.Parallel()
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"error":{"code":"forbidden","message":"no access"}}`))
+	}))
+	defer server.Close()
+
+	client := &TsugaClient{
+		BaseURL:            server.URL,
+		ConsistencyTimeout: time.Minute,
+		client:             server.Client(),
+		pollInterval:       time.Millisecond,
+	}
+
+	err := client.waitForConsistency(context.Background(), "/v1/teams/t1", anyObject)
+	if err == nil || !strings.Contains(err.Error(), "no access") {
+		t.Fatalf("waitForConsistency() error = %v, want the API error", err)
+	}
+	if requests != 1 {
+		t.Errorf("waitForConsistency() made %d requests, want 1", requests)
+	}
+}
+
+func TestWaitForConsistency_Disabled(t *testing.T) {
+	t.Parallel()
+
+	client := &TsugaClient{BaseURL: "http://127.0.0.1:0"}
+	if err := client.waitForConsistency(context.Background(), "/v1/teams/t1", anyObject); err != nil {
+		t.Fatalf("waitForConsistency() with no timeout error = %v", err)
+	}
+}
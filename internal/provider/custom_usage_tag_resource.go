@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"terraform-provider-tsuga/internal/resource_custom_usage_tag"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -25,6 +26,12 @@ type customUsageTagResource struct {
 	client *TsugaClient
 }
 
+// customUsageTagResourceModel adds the `timeouts` block to the generated custom usage tag model.
+type customUsageTagResourceModel struct {
+	resource_custom_usage_tag.CustomUsageTagModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (r *customUsageTagResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -47,7 +54,7 @@ func (r *customUsageTagResource) Metadata(_ context.Context, req resource.Metada
 }
 
 func (r *customUsageTagResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = resource_custom_usage_tag.CustomUsageTagResourceSchema(ctx)
+	resp.Schema = withTimeouts(ctx, withStableID(resource_custom_usage_tag.CustomUsageTagResourceSchema(ctx)))
 }
 
 func (r *customUsageTagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -55,7 +62,7 @@ func (r *customUsageTagResource) ImportState(ctx context.Context, req resource.I
 }
 
 func (r *customUsageTagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan resource_custom_usage_tag.CustomUsageTagModel
+	var plan customUsageTagResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
@@ -63,6 +70,15 @@ func (r *customUsageTagResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	requestBody := map[string]interface{}{
 		"tagKey": plan.TagKey.ValueString(),
 	}
@@ -99,7 +115,7 @@ func (r *customUsageTagResource) Create(ctx context.Context, req resource.Create
 }
 
 func (r *customUsageTagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state resource_custom_usage_tag.CustomUsageTagModel
+	var state customUsageTagResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
@@ -107,6 +123,15 @@ func (r *customUsageTagResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	apiPath := fmt.Sprintf("/v1/custom-usage-tags/%s", state.Id.ValueString())
 	httpResp, err := r.client.doRequest(ctx, http.MethodGet, apiPath, nil)
 	if err != nil {
@@ -144,12 +169,26 @@ func (r *customUsageTagResource) Read(ctx context.Context, req resource.ReadRequ
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-func (r *customUsageTagResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError("Update Not Supported", "Custom usage tags cannot be updated in place.")
+// Update only stores the plan: tag_key forces replacement, so the `timeouts`
+// block is all that can change in place.
+func (r *customUsageTagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan customUsageTagResourceModel
+	var state customUsageTagResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = state.Id
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *customUsageTagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state resource_custom_usage_tag.CustomUsageTagModel
+	var state customUsageTagResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
@@ -157,6 +196,15 @@ func (r *customUsageTagResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	apiPath := fmt.Sprintf("/v1/custom-usage-tags/%s", state.Id.ValueString())
 	httpResp, err := r.client.doRequest(ctx, http.MethodDelete, apiPath, map[string]interface{}{})
 	if err != nil {
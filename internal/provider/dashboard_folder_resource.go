@@ -9,6 +9,7 @@ import (
 
 	"terraform-provider-tsuga/internal/resource_dashboard_folder"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -28,6 +29,12 @@ type dashboardFolderResource struct {
 	client *TsugaClient
 }
 
+// dashboardFolderResourceModel adds the `timeouts` block to the generated dashboard folder model.
+type dashboardFolderResourceModel struct {
+	resource_dashboard_folder.DashboardFolderModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (r *dashboardFolderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -50,7 +57,7 @@ func (r *dashboardFolderResource) Metadata(_ context.Context, req resource.Metad
 }
 
 func (r *dashboardFolderResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = resource_dashboard_folder.DashboardFolderResourceSchema(ctx)
+	resp.Schema = withTimeouts(ctx, resource_dashboard_folder.DashboardFolderResourceSchema(ctx))
 }
 
 func (r *dashboardFolderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -58,7 +65,7 @@ func (r *dashboardFolderResource) ImportState(ctx context.Context, req resource.
 }
 
 func (r *dashboardFolderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan resource_dashboard_folder.DashboardFolderModel
+	var plan dashboardFolderResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
@@ -66,7 +73,16 @@ func (r *dashboardFolderResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	requestBody, diags := dashboardFolderRequestBody(ctx, plan)
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	requestBody, diags := dashboardFolderRequestBody(ctx, plan.DashboardFolderModel)
 	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
@@ -85,7 +101,7 @@ func (r *dashboardFolderResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	resp.Diagnostics.Append(applyDashboardFolderResponse(ctx, httpResp.Body, &plan)...)
+	resp.Diagnostics.Append(applyDashboardFolderResponse(ctx, httpResp.Body, &plan.DashboardFolderModel)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -95,7 +111,7 @@ func (r *dashboardFolderResource) Create(ctx context.Context, req resource.Creat
 }
 
 func (r *dashboardFolderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state resource_dashboard_folder.DashboardFolderModel
+	var state dashboardFolderResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
@@ -103,6 +119,15 @@ func (r *dashboardFolderResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	apiPath := fmt.Sprintf("/v1/dashboard-folders/%s", state.Id.ValueString())
 	httpResp, err := r.client.doRequest(ctx, http.MethodGet, apiPath, nil)
 	if err != nil {
@@ -121,7 +146,7 @@ func (r *dashboardFolderResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	resp.Diagnostics.Append(applyDashboardFolderResponse(ctx, httpResp.Body, &state)...)
+	resp.Diagnostics.Append(applyDashboardFolderResponse(ctx, httpResp.Body, &state.DashboardFolderModel)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -131,8 +156,8 @@ func (r *dashboardFolderResource) Read(ctx context.Context, req resource.ReadReq
 }
 
 func (r *dashboardFolderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan resource_dashboard_folder.DashboardFolderModel
-	var state resource_dashboard_folder.DashboardFolderModel
+	var plan dashboardFolderResourceModel
+	var state dashboardFolderResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -141,7 +166,16 @@ func (r *dashboardFolderResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	requestBody, diags := dashboardFolderRequestBody(ctx, plan)
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	requestBody, diags := dashboardFolderRequestBody(ctx, plan.DashboardFolderModel)
 	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
@@ -161,7 +195,7 @@ func (r *dashboardFolderResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	resp.Diagnostics.Append(applyDashboardFolderResponse(ctx, httpResp.Body, &plan)...)
+	resp.Diagnostics.Append(applyDashboardFolderResponse(ctx, httpResp.Body, &plan.DashboardFolderModel)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -171,7 +205,7 @@ func (r *dashboardFolderResource) Update(ctx context.Context, req resource.Updat
 }
 
 func (r *dashboardFolderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state resource_dashboard_folder.DashboardFolderModel
+	var state dashboardFolderResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
@@ -179,6 +213,15 @@ func (r *dashboardFolderResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	apiPath := fmt.Sprintf("/v1/dashboard-folders/%s", state.Id.ValueString())
 	httpResp, err := r.client.doRequest(ctx, http.MethodDelete, apiPath, map[string]interface{}{})
 	if err != nil {
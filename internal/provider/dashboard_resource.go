@@ -8,6 +8,8 @@ import (
 	"net/http"
 
 	"terraform-provider-tsuga/internal/aggregate"
+	"terraform-provider-tsuga/internal/customtypes"
+	"terraform-provider-tsuga/internal/filterexpression"
 	"terraform-provider-tsuga/internal/groupby"
 	"terraform-provider-tsuga/internal/normalizer"
 	"terraform-provider-tsuga/internal/resource_dashboard"
@@ -22,6 +24,7 @@ import (
 var _ resource.Resource = (*dashboardResource)(nil)
 var _ resource.ResourceWithConfigure = (*dashboardResource)(nil)
 var _ resource.ResourceWithImportState = (*dashboardResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*dashboardResource)(nil)
 var _ resource.ResourceWithValidateConfig = (*dashboardResource)(nil)
 
 func NewDashboardResource() resource.Resource {
@@ -54,7 +57,7 @@ func (r *dashboardResource) Metadata(_ context.Context, req resource.MetadataReq
 }
 
 func (r *dashboardResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = resource_dashboard.DashboardResourceSchema(ctx)
+	resp.Schema = withTimeouts(ctx, withDeletionProtection(resource_dashboard.DashboardResourceSchema(ctx)))
 }
 
 func (r *dashboardResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -235,10 +238,14 @@ func (r *dashboardResource) validateTableVisualization(ctx context.Context, tabl
 				return diags
 			}
 			for j, query := range queries {
+				diags.Append(validateQuerySyntax(query.Filter.StringValue, fmt.Sprintf("%s.columns[%d].queries[%d].filter", pathPrefix, i, j))...)
+				diags.Append(validateFilterExpression(ctx, query.FilterExpression, fmt.Sprintf("%s.columns[%d].queries[%d].filter_expression", pathPrefix, i, j))...)
 				aggDiags := r.validateAggregate(query.Aggregate, fmt.Sprintf("%s.columns[%d].queries[%d].aggregate", pathPrefix, i, j))
 				diags.Append(aggDiags...)
 				diags.Append(r.validateQueryFunctions(ctx, query.Functions, fmt.Sprintf("%s.columns[%d].queries[%d]", pathPrefix, i, j))...)
 			}
+			formulas := []formulaAttribute{{value: col.Formula, path: fmt.Sprintf("%s.columns[%d].formula", pathPrefix, i)}}
+			diags.Append(validateFormulas(formulas, len(queries), fmt.Sprintf("%s.columns[%d].queries", pathPrefix, i))...)
 		}
 		diags.Append(normalizer.Validate(col.Normalizer, fmt.Sprintf("%s.columns[%d]", pathPrefix, i))...)
 	}
@@ -258,10 +265,16 @@ func (r *dashboardResource) validateSeriesVisualization(ctx context.Context, sv
 		}
 
 		for i, query := range queries {
+			diags.Append(validateQuerySyntax(query.Filter.StringValue, fmt.Sprintf("%s.queries[%d].filter", pathPrefix, i))...)
+			diags.Append(validateFilterExpression(ctx, query.FilterExpression, fmt.Sprintf("%s.queries[%d].filter_expression", pathPrefix, i))...)
 			aggDiags := r.validateAggregate(query.Aggregate, fmt.Sprintf("%s.queries[%d].aggregate", pathPrefix, i))
 			diags.Append(aggDiags...)
 			diags.Append(r.validateQueryFunctions(ctx, query.Functions, fmt.Sprintf("%s.queries[%d]", pathPrefix, i))...)
 		}
+
+		// Without a formula every query is displayed on its own
+		formulas := []formulaAttribute{{value: sv.Formula, path: pathPrefix + ".formula"}}
+		diags.Append(validateFormulas(formulas, len(queries), pathPrefix+".queries")...)
 	}
 
 	diags.Append(normalizer.Validate(sv.Normalizer, pathPrefix)...)
@@ -347,6 +360,11 @@ func (r *dashboardResource) ImportState(ctx context.Context, req resource.Import
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *dashboardResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnDeletionProtection(ctx, "dashboard", req, resp)
+	warnMetricQueries(ctx, r.client, req, resp, dashboardMetricQueries)
+}
+
 func (r *dashboardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan resource_dashboard.DashboardModel
 
@@ -355,19 +373,40 @@ func (r *dashboardResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	requestBody, diags := r.buildDashboardRequestBody(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	newState, diags := r.createOrUpdateDashboard(ctx, http.MethodPost, "/v1/dashboards", requestBody, "create")
+	key, diags := newIdempotencyKey("tsuga_dashboard")
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withIdempotencyKey(ctx, key)
 
+	newState, apiData, diags := r.createOrUpdateDashboard(ctx, http.MethodPost, "/v1/dashboards", requestBody, "create")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState.DeletionProtection = plan.DeletionProtection
+	newState.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(setRemoteSnapshot(ctx, resp.Private, apiData)...)
+	resp.Diagnostics.Append(setIdempotencyKey(ctx, resp.Private, key)...)
+	resp.Diagnostics.Append(preserveFilterExpressions(req.Plan.Raw, &resp.State)...)
 }
 
 func (r *dashboardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -377,43 +416,37 @@ func (r *dashboardResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	path := fmt.Sprintf("/v1/dashboards/%s", state.Id.ValueString())
-	httpResp, err := r.client.doRequest(ctx, http.MethodGet, path, nil)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read dashboard: %s", err))
-		return
-	}
-	defer func() { _ = httpResp.Body.Close() }()
-
-	if httpResp.StatusCode == http.StatusNotFound {
-		resp.State.RemoveResource(ctx)
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if err := r.client.checkResponse(httpResp); err != nil {
-		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read dashboard: %s", err))
-		return
-	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
 
-	raw, err := io.ReadAll(httpResp.Body)
-	if err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to read response body: %s", err))
+	data, found, diags := r.fetchDashboard(ctx, state.Id.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var apiResp dashboardAPIResponse
-	if err := json.Unmarshal(raw, &apiResp); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err))
+	if !found {
+		resp.State.RemoveResource(ctx)
 		return
 	}
 
-	newState, diags := flattenDashboard(ctx, apiResp.Data)
+	newState, diags := flattenDashboard(ctx, data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	newState.DeletionProtection = state.DeletionProtection
+	newState.Timeouts = state.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(setRemoteSnapshot(ctx, resp.Private, data)...)
+	resp.Diagnostics.Append(preserveFilterExpressions(req.State.Raw, &resp.State)...)
 }
 
 func (r *dashboardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -426,6 +459,28 @@ func (r *dashboardResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Refuse to overwrite changes made since the plan, such as in the Tsuga UI.
+	current, found, diags := r.fetchDashboard(ctx, state.Id.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if found {
+		resp.Diagnostics.Append(checkRemoteUnchanged(ctx, req.Private, "dashboard", state.Id.ValueString(), current)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	requestBody, diags := r.buildDashboardRequestBody(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -433,13 +488,17 @@ func (r *dashboardResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	path := fmt.Sprintf("/v1/dashboards/%s", state.Id.ValueString())
-	newState, diags := r.createOrUpdateDashboard(ctx, http.MethodPut, path, requestBody, "update")
+	newState, apiData, diags := r.createOrUpdateDashboard(ctx, http.MethodPut, path, requestBody, "update")
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	newState.DeletionProtection = plan.DeletionProtection
+	newState.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(setRemoteSnapshot(ctx, resp.Private, apiData)...)
+	resp.Diagnostics.Append(preserveFilterExpressions(req.Plan.Raw, &resp.State)...)
 }
 
 func (r *dashboardResource) buildDashboardRequestBody(ctx context.Context, plan resource_dashboard.DashboardModel) (map[string]interface{}, diag.Diagnostics) {
@@ -481,40 +540,81 @@ func (r *dashboardResource) buildDashboardRequestBody(ctx context.Context, plan
 	return body, diags
 }
 
-func (r *dashboardResource) createOrUpdateDashboard(ctx context.Context, method, path string, requestBody map[string]interface{}, operation string) (resource_dashboard.DashboardModel, diag.Diagnostics) {
+func (r *dashboardResource) createOrUpdateDashboard(ctx context.Context, method, path string, requestBody map[string]interface{}, operation string) (resource_dashboard.DashboardModel, dashboardAPIData, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	httpResp, err := r.client.doRequest(ctx, method, path, requestBody)
+	var httpResp *http.Response
+	var err error
+	if method == http.MethodPost {
+		httpResp, err = r.client.createIdempotently(ctx, path, requestBody)
+	} else {
+		httpResp, err = r.client.doRequest(ctx, method, path, requestBody)
+	}
 	if err != nil {
 		diags.AddError("Client Error", fmt.Sprintf("Unable to %s dashboard: %s", operation, err))
-		return resource_dashboard.DashboardModel{}, diags
+		return resource_dashboard.DashboardModel{}, dashboardAPIData{}, diags
 	}
 	defer func() { _ = httpResp.Body.Close() }()
 
 	if err := r.client.checkResponse(httpResp); err != nil {
 		diags.AddError("API Error", fmt.Sprintf("Unable to %s dashboard: %s", operation, err))
-		return resource_dashboard.DashboardModel{}, diags
+		return resource_dashboard.DashboardModel{}, dashboardAPIData{}, diags
 	}
 
 	raw, err := io.ReadAll(httpResp.Body)
 	if err != nil {
 		diags.AddError("Parse Error", fmt.Sprintf("Unable to read response body: %s", err))
-		return resource_dashboard.DashboardModel{}, diags
+		return resource_dashboard.DashboardModel{}, dashboardAPIData{}, diags
 	}
 
 	var apiResp dashboardAPIResponse
 	if err := json.Unmarshal(raw, &apiResp); err != nil {
 		diags.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err))
-		return resource_dashboard.DashboardModel{}, diags
+		return resource_dashboard.DashboardModel{}, dashboardAPIData{}, diags
 	}
 
 	newState, flattenDiags := flattenDashboard(ctx, apiResp.Data)
 	diags.Append(flattenDiags...)
 	if diags.HasError() {
-		return resource_dashboard.DashboardModel{}, diags
+		return resource_dashboard.DashboardModel{}, dashboardAPIData{}, diags
 	}
 
-	return newState, diags
+	return newState, apiResp.Data, diags
+}
+
+// fetchDashboard reads a dashboard, with found false when it no longer exists.
+func (r *dashboardResource) fetchDashboard(ctx context.Context, id string) (dashboardAPIData, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	httpResp, err := r.client.doRequest(ctx, http.MethodGet, fmt.Sprintf("/v1/dashboards/%s", id), nil)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read dashboard: %s", err))
+		return dashboardAPIData{}, false, diags
+	}
+	defer func() { _ = httpResp.Body.Close() }()
+
+	if httpResp.StatusCode == http.StatusNotFound {
+		return dashboardAPIData{}, false, diags
+	}
+
+	if err := r.client.checkResponse(httpResp); err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Unable to read dashboard: %s", err))
+		return dashboardAPIData{}, false, diags
+	}
+
+	raw, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		diags.AddError("Parse Error", fmt.Sprintf("Unable to read response body: %s", err))
+		return dashboardAPIData{}, false, diags
+	}
+
+	var apiResp dashboardAPIResponse
+	if err := json.Unmarshal(raw, &apiResp); err != nil {
+		diags.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err))
+		return dashboardAPIData{}, false, diags
+	}
+
+	return apiResp.Data, true, diags
 }
 
 func (r *dashboardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -524,6 +624,20 @@ func (r *dashboardResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.Append(deletionProtectionError("dashboard", state.Id))
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	path := fmt.Sprintf("/v1/dashboards/%s", state.Id.ValueString())
 	httpResp, err := r.client.doRequest(ctx, http.MethodDelete, path, map[string]interface{}{})
 	if err != nil {
@@ -1568,10 +1682,11 @@ func flattenQueries(queries []dashboardQuery) (types.List, diag.Diagnostics) {
 		}
 
 		values = append(values, types.ObjectValueMust(resource_dashboard.QueryAttrTypes(), map[string]attr.Value{
-			"aggregate":      aggVal,
-			"filter":         stringValueOrNull(q.Filter),
-			"functions":      funcVal,
-			"time_aggregate": stringValueOrNull(q.TimeAggregate),
+			"aggregate":         aggVal,
+			"filter":            customtypes.QueryValue{StringValue: stringValueOrNull(q.Filter)},
+			"filter_expression": filterexpression.Null(),
+			"functions":         funcVal,
+			"time_aggregate":    stringValueOrNull(q.TimeAggregate),
 		}))
 	}
 
@@ -1773,12 +1888,14 @@ func expandQueries(ctx context.Context, queries types.List) ([]dashboardQuery, d
 		}
 		fns, fnDiags := expandFunctions(ctx, q.Functions)
 		diags.Append(fnDiags...)
+		filter, filterDiags := resolveQuery(ctx, q.Filter.StringValue, q.FilterExpression)
+		diags.Append(filterDiags...)
 		if diags.HasError() {
 			return nil, diags
 		}
 		result = append(result, dashboardQuery{
 			Aggregate:     agg,
-			Filter:        q.Filter.ValueString(),
+			Filter:        filter,
 			Functions:     fns,
 			TimeAggregate: q.TimeAggregate.ValueString(),
 		})
@@ -3,6 +3,8 @@ package provider
 import (
 	"context"
 	"terraform-provider-tsuga/internal/aggregate"
+	"terraform-provider-tsuga/internal/customtypes"
+	"terraform-provider-tsuga/internal/filterexpression"
 	"terraform-provider-tsuga/internal/resource_dashboard"
 	"testing"
 
@@ -227,10 +229,11 @@ func TestExpandFlattenQueries_TimeAggregateRoundTrips(t *testing.T) {
 
 	queries, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: resource_dashboard.QueryAttrTypes()},
 		[]resource_dashboard.QueryModel{{
-			Aggregate:     resource_dashboard.AggregateModel{Count: &aggregate.CountModel{Field: types.StringNull()}},
-			Filter:        types.StringValue("service:api"),
-			Functions:     types.ListNull(types.ObjectType{AttrTypes: resource_dashboard.FunctionAttrTypes()}),
-			TimeAggregate: types.StringValue("last"),
+			Aggregate:        resource_dashboard.AggregateModel{Count: &aggregate.CountModel{Field: types.StringNull()}},
+			Filter:           customtypes.NewQueryValue("service:api"),
+			FilterExpression: filterexpression.Null(),
+			Functions:        types.ListNull(types.ObjectType{AttrTypes: resource_dashboard.FunctionAttrTypes()}),
+			TimeAggregate:    types.StringValue("last"),
 		}})
 	if diags.HasError() {
 		t.Fatalf("failed to build queries: %v", diags)
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// withDeletionProtection adds the `deletion_protection` attribute to a resource
+// schema. The value only lives in Terraform state: it is never sent to the API.
+func withDeletionProtection(s schema.Schema) schema.Schema {
+	s.Attributes["deletion_protection"] = schema.BoolAttribute{
+		Optional: true,
+		Description: "Whether Terraform is prevented from deleting the resource. Defaults to `false`. " +
+			"While `true`, plans destroying the resource warn and applying them fails, whether the destroy comes from `terraform destroy`, " +
+			"removing the resource from the configuration or a replacement. Set it to `false` and apply before deleting the resource.",
+	}
+	return s
+}
+
+// deletionProtectionError is reported by Delete for a resource whose state has
+// `deletion_protection` set.
+func deletionProtectionError(kind string, id types.String) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Deletion Protection Enabled",
+		fmt.Sprintf("Cannot delete %s %s while deletion_protection is true. Set deletion_protection = false and apply, then delete it.", kind, id.ValueString()),
+	)
+}
+
+// warnDeletionProtection warns when a plan destroys a resource whose state has
+// `deletion_protection` set, since Delete will then fail at apply. Replacements
+// Terraform decides on its own, such as with -replace or replace_triggered_by,
+// are not visible to the provider at plan time and only fail at apply.
+func warnDeletionProtection(ctx context.Context, kind string, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || !req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var protected types.Bool
+	var id types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("deletion_protection"), &protected)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	if resp.Diagnostics.HasError() || !protected.ValueBool() {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Deletion Protection Enabled",
+		fmt.Sprintf("This plan destroys %s %s, which has deletion_protection set, so applying it will fail. Set deletion_protection = false and apply first if the deletion is intended.", kind, id.ValueString()),
+	)
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// durationPattern matches durations such as 90d, 12h, 1h30m or 45s.
+var durationPattern = regexp.MustCompile(`^(?:(\d+)d)?((?:\d+[hms])*)$`)
+
+// parseDuration parses a positive duration in Go syntax extended with a `d` unit
+// for days, which must come first: 90d, 36h, 1d12h. Attributes holding durations,
+// such as rotation periods and query windows, all use this syntax.
+func parseDuration(s string) (time.Duration, error) {
+	m := durationPattern.FindStringSubmatch(s)
+	if m == nil || s == "" {
+		return 0, fmt.Errorf("invalid duration %q, expected a number followed by d, h, m or s, such as 90d or 12h", s)
+	}
+
+	var d time.Duration
+	if m[1] != "" {
+		days, err := strconv.Atoi(m[1])
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q: %s", s, err)
+		}
+		d = time.Duration(days) * 24 * time.Hour
+	}
+	if m[2] != "" {
+		rest, err := time.ParseDuration(m[2])
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q: %s", s, err)
+		}
+		d += rest
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("invalid duration %q, must be greater than zero", s)
+	}
+	return d, nil
+}
@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDuration(t *testing.T) {
+	cases := map[string]time.Duration{
+		"90d":   90 * 24 * time.Hour,
+		"36h":   36 * time.Hour,
+		"1d12h": 36 * time.Hour,
+		"1h30m": 90 * time.Minute,
+		"45s":   45 * time.Second,
+	}
+	for s, want := range cases {
+		got, err := parseDuration(s)
+		if err != nil {
+			t.Errorf("parseDuration(%q) returned error: %s", s, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("parseDuration(%q) = %s, want %s", s, got, want)
+		}
+	}
+
+	for _, s := range []string{"", "0d", "12", "1w", "12h1d", "-1h", "1.5d"} {
+		if _, err := parseDuration(s); err == nil {
+			t.Errorf("parseDuration(%q) returned no error", s)
+		}
+	}
+}
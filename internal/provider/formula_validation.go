@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"terraform-provider-tsuga/internal/formula"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// formulaAttribute is a formula value and its path in the `a.b[0].c` notation.
+type formulaAttribute struct {
+	value types.String
+	path  string
+}
+
+// validateFormulas parses formulas that combine the queryCount queries at queriesPath.
+// Syntax errors and references to queries that do not exist are errors. Other
+// identifiers, which the API accepts when they name a formula alias, are warnings.
+// Once every formula is known and valid, and when none uses such an identifier,
+// queries that none of them reference are reported as warnings. Null formulas are
+// skipped.
+func validateFormulas(formulas []formulaAttribute, queryCount int, queriesPath string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	used := map[int]bool{}
+	complete, unknown, aliased := false, false, false
+	for _, f := range formulas {
+		if f.value.IsNull() {
+			continue
+		}
+		if f.value.IsUnknown() {
+			unknown = true
+			continue
+		}
+
+		src := f.value.ValueString()
+		parsed, err := formula.Parse(src)
+		var syntaxErr *formula.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			diags.AddAttributeError(
+				attributePath(f.path),
+				"Invalid formula",
+				fmt.Sprintf("%s: %s\n\n    %s\n    %s^", f.path, syntaxErr, src, strings.Repeat(" ", syntaxErr.Column-1)),
+			)
+			continue
+		}
+
+		complete = true
+		for _, ident := range parsed.Identifiers {
+			aliased = true
+			diags.AddAttributeWarning(
+				attributePath(f.path),
+				"Unknown formula identifier",
+				fmt.Sprintf("%s: %q is not a query reference such as q1. The formula is sent as is, and the API rejects it unless %q is a formula alias.", f.path, ident, ident),
+			)
+		}
+		for _, n := range parsed.References {
+			used[n] = true
+			if n > queryCount {
+				diags.AddAttributeError(
+					attributePath(f.path),
+					"Invalid formula",
+					fmt.Sprintf("%s: q%d does not exist, %s defines %d %s.", f.path, n, queriesPath, queryCount, pluralize(queryCount, "query", "queries")),
+				)
+			}
+		}
+	}
+
+	if !complete || unknown || aliased || diags.HasError() {
+		return diags
+	}
+	for i := 0; i < queryCount; i++ {
+		if !used[i+1] {
+			diags.AddAttributeWarning(
+				attributePath(fmt.Sprintf("%s[%d]", queriesPath, i)),
+				"Unused query",
+				fmt.Sprintf("%s[%d] (q%d) is not referenced by any formula.", queriesPath, i, i+1),
+			)
+		}
+	}
+	return diags
+}
+
+func pluralize(n int, singular, plural string) string {
+	if n == 1 {
+		return singular
+	}
+	return plural
+}
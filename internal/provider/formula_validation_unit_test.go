@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateFormulas(t *testing.T) {
+	formulas := func(values ...types.String) []formulaAttribute {
+		var out []formulaAttribute
+		for i, v := range values {
+			out = append(out, formulaAttribute{value: v, path: fmt.Sprintf("configuration.metric.conditions[%d].formula", i)})
+		}
+		return out
+	}
+
+	cases := []struct {
+		name     string
+		formulas []formulaAttribute
+		queries  int
+		errors   []string
+		warnings []string
+	}{
+		{
+			name:     "all queries referenced",
+			formulas: formulas(types.StringValue("(q1 / (q1 + q2)) * 100")),
+			queries:  2,
+		},
+		{
+			name:     "queries used across conditions",
+			formulas: formulas(types.StringValue("q1"), types.StringValue("q2 * 2")),
+			queries:  2,
+		},
+		{
+			name:     "syntax error",
+			formulas: formulas(types.StringValue("(q1 + q2")),
+			queries:  2,
+			errors:   []string{"configuration.metric.conditions[0].formula: column 1: unclosed \"(\"\n\n    (q1 + q2\n    ^"},
+		},
+		{
+			name:     "missing query",
+			formulas: formulas(types.StringValue("q1 / q3")),
+			queries:  2,
+			errors:   []string{"configuration.metric.conditions[0].formula: q3 does not exist, configuration.metric.queries defines 2 queries."},
+		},
+		{
+			name:     "unused query",
+			formulas: formulas(types.StringValue("q2")),
+			queries:  3,
+			warnings: []string{
+				"configuration.metric.queries[0] (q1) is not referenced by any formula.",
+				"configuration.metric.queries[2] (q3) is not referenced by any formula.",
+			},
+		},
+		{
+			name:     "alias",
+			formulas: formulas(types.StringValue("error_rate * 100")),
+			queries:  2,
+			warnings: []string{
+				"configuration.metric.conditions[0].formula: \"error_rate\" is not a query reference such as q1. The formula is sent as is, and the API rejects it unless \"error_rate\" is a formula alias.",
+			},
+		},
+		{
+			name:     "unknown formula",
+			formulas: formulas(types.StringValue("q1"), types.StringUnknown()),
+			queries:  2,
+		},
+		{
+			name:     "formula after an unknown one",
+			formulas: formulas(types.StringUnknown(), types.StringValue("q1 / q3")),
+			queries:  2,
+			errors:   []string{"configuration.metric.conditions[1].formula: q3 does not exist, configuration.metric.queries defines 2 queries."},
+		},
+		{
+			name:     "no formula",
+			formulas: formulas(types.StringNull()),
+			queries:  2,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := validateFormulas(tc.formulas, tc.queries, "configuration.metric.queries")
+			if got := diagnosticDetails(diags.Errors()); strings.Join(got, "|") != strings.Join(tc.errors, "|") {
+				t.Errorf("errors = %q, want %q", got, tc.errors)
+			}
+			if got := diagnosticDetails(diags.Warnings()); strings.Join(got, "|") != strings.Join(tc.warnings, "|") {
+				t.Errorf("warnings = %q, want %q", got, tc.warnings)
+			}
+		})
+	}
+}
+
+func diagnosticDetails(diags diag.Diagnostics) []string {
+	var out []string
+	for _, d := range diags {
+		out = append(out, d.Detail())
+	}
+	return out
+}
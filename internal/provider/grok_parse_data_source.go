@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"terraform-provider-tsuga/internal/datasource_grok_parse"
+	"terraform-provider-tsuga/internal/grok"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*grokParseDataSource)(nil)
+
+func NewGrokParseDataSource() datasource.DataSource {
+	return &grokParseDataSource{}
+}
+
+// grokParseDataSource runs Grok rules through the offline engine. It needs no API
+// client, so it does not implement Configure.
+type grokParseDataSource struct{}
+
+func (d *grokParseDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_grok_parse"
+}
+
+func (d *grokParseDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_grok_parse.GrokParseDataSourceSchema(ctx)
+}
+
+func (d *grokParseDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config datasource_grok_parse.GrokParseModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, diags := expandStringList(ctx, config.Rules)
+	resp.Diagnostics.Append(diags...)
+	samples, diags := expandStringList(ctx, config.Samples)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	compiled := make([]*grok.Rule, 0, len(rules))
+	for i, rule := range rules {
+		c, err := grok.Compile(rule)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Grok rule", fmt.Sprintf("rules[%d]: %s", i, err))
+			continue
+		}
+		compiled = append(compiled, c)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	results := make([]attr.Value, 0, len(samples))
+	for _, sample := range samples {
+		extracted := types.StringNull()
+		fields, matched := grok.MatchFirst(compiled, sample)
+		if matched {
+			encoded, err := json.Marshal(fields)
+			if err != nil {
+				resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to encode extracted fields: %s", err))
+				return
+			}
+			extracted = types.StringValue(string(encoded))
+		}
+		results = append(results, types.ObjectValueMust(datasource_grok_parse.ResultAttrTypes(), map[string]attr.Value{
+			"sample":    types.StringValue(sample),
+			"matched":   types.BoolValue(matched),
+			"extracted": extracted,
+		}))
+	}
+
+	resultsList, diags := types.ListValue(types.ObjectType{AttrTypes: datasource_grok_parse.ResultAttrTypes()}, results)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config.Results = resultsList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
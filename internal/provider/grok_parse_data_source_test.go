@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGrokParseDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "tsuga_grok_parse" "test" {
+  rules = [
+    "%%{IPV4:client_ip} %%{WORD:method} %%{INT:status:integer}",
+  ]
+  samples = [
+    "10.0.0.1 GET 200",
+    "not an access log",
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.tsuga_grok_parse.test", "results.#", "2"),
+					resource.TestCheckResourceAttr("data.tsuga_grok_parse.test", "results.0.matched", "true"),
+					resource.TestCheckResourceAttr("data.tsuga_grok_parse.test", "results.0.extracted", `{"client_ip":"10.0.0.1","method":"GET","status":200}`),
+					resource.TestCheckResourceAttr("data.tsuga_grok_parse.test", "results.1.matched", "false"),
+					resource.TestCheckNoResourceAttr("data.tsuga_grok_parse.test", "results.1.extracted"),
+				),
+			},
+		},
+	})
+}
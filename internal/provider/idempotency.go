@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// idempotencyKeyHeader carries the key of a create, so that a retried POST can be
+// told apart from a new one. The public API does not document the header, so the
+// provider does not count on it and searches for the object instead, as described on
+// createIdempotently.
+const idempotencyKeyHeader = "Idempotency-Key"
+
+// idempotencyKeyPrivateKey is the private state key recording the idempotency key
+// the resource was created with.
+const idempotencyKeyPrivateKey = "idempotency_key"
+
+// maxCreateAttempts bounds the POSTs of createIdempotently.
+const maxCreateAttempts = 3
+
+// createRetryInterval is the wait before the second attempt of createIdempotently,
+// doubling for each one after.
+const createRetryInterval = time.Second
+
+type idempotencyKeyContextKey struct{}
+
+// newIdempotencyKey returns a fresh idempotency key for a create of resourceType,
+// such as "tsuga_monitor".
+func newIdempotencyKey(resourceType string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		diags.AddError("Internal Error", fmt.Sprintf("Unable to generate idempotency key: %s", err))
+		return "", diags
+	}
+	return resourceType + ":" + id, diags
+}
+
+// withIdempotencyKey returns ctx with key attached: doRequest sends it with every
+// POST made with the returned context. An empty key detaches it. Create generates
+// the key, as the framework hands it no private state, and records it in private
+// state once the object exists.
+func withIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyContextKey{}, key)
+}
+
+// setIdempotencyKey records the idempotency key of a created resource.
+func setIdempotencyKey(ctx context.Context, private privateStateSetter, key string) diag.Diagnostics {
+	raw, err := json.Marshal(key)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Internal Error", fmt.Sprintf("Unable to encode idempotency key: %s", err))
+		return diags
+	}
+	return private.SetKey(ctx, idempotencyKeyPrivateKey, raw)
+}
+
+func idempotencyKey(ctx context.Context) string {
+	key, _ := ctx.Value(idempotencyKeyContextKey{}).(string)
+	return key
+}
+
+// createIdempotently POSTs body to path like doRequest, retrying after failures
+// that leave it unknown whether the API created the object: transport errors,
+// timeouts included, and 408, 500, 502, 503 and 504 responses. The retries carry
+// the same idempotency key, taken from ctx.
+//
+// As the API cannot be searched by idempotency key, the first POST and each retry
+// are preceded by a search of path's query endpoint for an object with the name and
+// owner of body, and the same value for every other top-level string, number and
+// boolean of body. A single match is taken to be the object an earlier attempt
+// created, in this apply or in one that failed before recording it, and its GET
+// response is returned in place of the POST response; several matches are an error.
+// The search still runs when ctx is done, as a POST timing out after the API
+// committed is the case it exists for. It is sent without the idempotency key, which
+// belongs to the create.
+func (c *TsugaClient) createIdempotently(ctx context.Context, path string, body map[string]interface{}) (*http.Response, error) {
+	lookupCtx := withIdempotencyKey(context.WithoutCancel(ctx), "")
+	id, found, err := c.findCreated(lookupCtx, path, body)
+	if err != nil {
+		return nil, fmt.Errorf("looking for an object an earlier create may have made failed: %w", err)
+	}
+	if found {
+		return c.doRequest(lookupCtx, http.MethodGet, fmt.Sprintf("%s/%s", path, id), nil)
+	}
+
+	interval := createRetryInterval
+	for attempt := 1; ; attempt++ {
+		httpResp, err := c.doRequest(ctx, http.MethodPost, path, body)
+		if !ambiguousFailure(httpResp, err) {
+			return httpResp, err
+		}
+		if err == nil {
+			err = c.checkResponse(httpResp)
+		}
+
+		id, found, lookupErr := c.findCreated(lookupCtx, path, body)
+		if lookupErr != nil {
+			return nil, fmt.Errorf("%w; looking for an object the request may have created failed: %s", err, lookupErr)
+		}
+		if found {
+			return c.doRequest(lookupCtx, http.MethodGet, fmt.Sprintf("%s/%s", path, id), nil)
+		}
+		if attempt == maxCreateAttempts {
+			return nil, err
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, err
+		case <-timer.C:
+		}
+		interval *= 2
+	}
+}
+
+// ambiguousFailure reports whether a POST failed in a way that may still have
+// created the object.
+func ambiguousFailure(httpResp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch httpResp.StatusCode {
+	case http.StatusRequestTimeout, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// findCreated searches path's query endpoint for the object a POST of body may
+// have created, as described on createIdempotently.
+func (c *TsugaClient) findCreated(ctx context.Context, path string, body map[string]interface{}) (string, bool, error) {
+	raw, err := json.Marshal(body)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to marshal request body: %w", err)
+	}
+	var want map[string]any
+	if err := json.Unmarshal(raw, &want); err != nil {
+		return "", false, fmt.Errorf("failed to decode request body: %w", err)
+	}
+	name, _ := want["name"].(string)
+	owner, _ := want["owner"].(string)
+	if name == "" || owner == "" {
+		return "", false, errors.New("the request has no name and owner to search by")
+	}
+
+	query := map[string]interface{}{
+		"limit": 100,
+		"filters": map[string]interface{}{
+			"owners":      map[string]interface{}{"values": []string{owner}},
+			"searchQuery": map[string]interface{}{"value": name},
+		},
+	}
+	httpResp, err := c.doRequest(ctx, http.MethodPost, path+"/query", query)
+	if err != nil {
+		return "", false, err
+	}
+	defer func() { _ = httpResp.Body.Close() }()
+
+	if err := c.checkResponse(httpResp); err != nil {
+		return "", false, err
+	}
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return "", false, fmt.Errorf("unable to read response body: %w", err)
+	}
+	var apiResp struct {
+		Data []map[string]any `json:"data"`
+	}
+	if err := json.Unmarshal(respBody, &apiResp); err != nil {
+		return "", false, fmt.Errorf("unable to parse response: %w", err)
+	}
+
+	var ids []string
+	for _, candidate := range apiResp.Data {
+		if id, ok := candidate["id"].(string); ok && sameScalarFields(want, candidate) {
+			ids = append(ids, id)
+		}
+	}
+	switch len(ids) {
+	case 0:
+		return "", false, nil
+	case 1:
+		return ids[0], true, nil
+	default:
+		return "", false, fmt.Errorf("found %d objects named %q owned by %s, %v; import the one to manage", len(ids), name, owner, ids)
+	}
+}
+
+// sameScalarFields reports whether got holds the same value as want for every
+// top-level string, number and boolean of want. Lists and objects are skipped, as
+// the API may reorder or normalize them.
+func sameScalarFields(want, got map[string]any) bool {
+	for key, value := range want {
+		switch value.(type) {
+		case string, float64, bool:
+			if !reflect.DeepEqual(got[key], value) {
+				return false
+			}
+		}
+	}
+	return true
+}
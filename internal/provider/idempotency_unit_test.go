@@ -0,0 +1,228 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeMonitorsAPI serves /v1/monitors, creating monitors on POST and answering
+// queries by owner and name. failCreates makes the next POSTs fail after committing
+// (commitFirst) or before.
+type fakeMonitorsAPI struct {
+	mu          sync.Mutex
+	monitors    []map[string]any
+	keys        []string
+	queryKeys   []string
+	failCreates int
+	commitFirst bool
+	delay       time.Duration
+}
+
+func (f *fakeMonitorsAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/v1/monitors":
+		f.keys = append(f.keys, r.Header.Get(idempotencyKeyHeader))
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		body["id"] = "m" + string(rune('0'+len(f.monitors)+1))
+
+		fail := f.failCreates > 0
+		if fail {
+			f.failCreates--
+		}
+		if !fail || f.commitFirst {
+			f.monitors = append(f.monitors, body)
+		}
+		if fail {
+			if f.delay > 0 {
+				f.mu.Unlock()
+				time.Sleep(f.delay)
+				f.mu.Lock()
+			}
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": body})
+
+	case r.Method == http.MethodPost && r.URL.Path == "/v1/monitors/query":
+		f.queryKeys = append(f.queryKeys, r.Header.Get(idempotencyKeyHeader))
+		var query struct {
+			Filters struct {
+				Owners      struct{ Values []string }
+				SearchQuery struct{ Value string }
+			}
+		}
+		_ = json.NewDecoder(r.Body).Decode(&query)
+		data := []map[string]any{}
+		for _, m := range f.monitors {
+			if m["owner"] == query.Filters.Owners.Values[0] && strings.Contains(m["name"].(string), query.Filters.SearchQuery.Value) {
+				data = append(data, m)
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": data})
+
+	case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/v1/monitors/"):
+		for _, m := range f.monitors {
+			if "/v1/monitors/"+m["id"].(string) == r.URL.Path {
+				_ = json.NewEncoder(w).Encode(map[string]any{"data": m})
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func createMonitorIdempotently(t *testing.T, ctx context.Context, api *fakeMonitorsAPI, body map[string]interface{}) (string, error) {
+	t.Helper()
+
+	server := httptest.NewServer(api)
+	defer server.Close()
+	client := &TsugaClient{BaseURL: server.URL, client: server.Client()}
+
+	httpResp, err := client.createIdempotently(withIdempotencyKey(ctx, "tsuga_monitor:k1"), "/v1/monitors", body)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = httpResp.Body.Close() }()
+	if err := client.checkResponse(httpResp); err != nil {
+		return "", err
+	}
+	raw, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		t.Fatalf("reading response: %v", err)
+	}
+	var resp struct {
+		Data struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(raw, &resp); err != nil {
+		t.Fatalf("parsing response: %v", err)
+	}
+	return resp.Data.ID, nil
+}
+
+func TestCreateIdempotently_TimeoutAfterCommit(t *testing.T) {
+	t.Parallel()
+
+	api := &fakeMonitorsAPI{failCreates: 1, commitFirst: true, delay: 200 * time.Millisecond}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	id, err := createMonitorIdempotently(t, ctx, api, map[string]interface{}{"name": "latency", "owner": "team-1", "priority": 3})
+	if err != nil {
+		t.Fatalf("createIdempotently() error = %v", err)
+	}
+	if id != "m1" {
+		t.Errorf("createIdempotently() returned %q, want the committed monitor m1", id)
+	}
+	if len(api.monitors) != 1 || len(api.keys) != 1 {
+		t.Errorf("got %d monitors from %d POSTs, want 1 from 1", len(api.monitors), len(api.keys))
+	}
+	if api.keys[0] != "tsuga_monitor:k1" {
+		t.Errorf("%s header = %q, want tsuga_monitor:k1", idempotencyKeyHeader, api.keys[0])
+	}
+	if len(api.queryKeys) != 2 || api.queryKeys[0] != "" || api.queryKeys[1] != "" {
+		t.Errorf("queries carried %s headers %q, want two queries without", idempotencyKeyHeader, api.queryKeys)
+	}
+}
+
+func TestCreateIdempotently_RetriesWithSameKey(t *testing.T) {
+	t.Parallel()
+
+	// An unrelated monitor with the same name and owner, but another priority.
+	api := &fakeMonitorsAPI{
+		failCreates: 1,
+		monitors:    []map[string]any{{"id": "m0", "name": "latency", "owner": "team-1", "priority": 1.0}},
+	}
+
+	id, err := createMonitorIdempotently(t, context.Background(), api, map[string]interface{}{"name": "latency", "owner": "team-1", "priority": 3})
+	if err != nil {
+		t.Fatalf("createIdempotently() error = %v", err)
+	}
+	if id != "m2" {
+		t.Errorf("createIdempotently() returned %q, want the retried monitor m2", id)
+	}
+	if len(api.keys) != 2 || api.keys[0] != api.keys[1] {
+		t.Errorf("POSTs carried keys %v, want the same key twice", api.keys)
+	}
+}
+
+func TestCreateIdempotently_AdoptsEarlierCreate(t *testing.T) {
+	t.Parallel()
+
+	// A monitor an earlier apply created before its POST timed out.
+	api := &fakeMonitorsAPI{
+		monitors: []map[string]any{{"id": "m1", "name": "latency", "owner": "team-1", "priority": 3.0}},
+	}
+
+	id, err := createMonitorIdempotently(t, context.Background(), api, map[string]interface{}{"name": "latency", "owner": "team-1", "priority": 3})
+	if err != nil {
+		t.Fatalf("createIdempotently() error = %v", err)
+	}
+	if id != "m1" {
+		t.Errorf("createIdempotently() returned %q, want the earlier monitor m1", id)
+	}
+	if len(api.monitors) != 1 || len(api.keys) != 0 {
+		t.Errorf("got %d monitors from %d POSTs, want 1 from 0", len(api.monitors), len(api.keys))
+	}
+}
+
+func TestCreateIdempotently_SeveralMatches(t *testing.T) {
+	t.Parallel()
+
+	api := &fakeMonitorsAPI{
+		monitors: []map[string]any{
+			{"id": "m0", "name": "latency", "owner": "team-1", "priority": 3.0},
+			{"id": "m1", "name": "latency", "owner": "team-1", "priority": 3.0},
+		},
+	}
+
+	_, err := createMonitorIdempotently(t, context.Background(), api, map[string]interface{}{"name": "latency", "owner": "team-1", "priority": 3})
+	if err == nil || !strings.Contains(err.Error(), "found 2 objects") {
+		t.Fatalf("createIdempotently() error = %v, want one listing both matches", err)
+	}
+	if len(api.keys) != 0 {
+		t.Errorf("got %d POSTs, want none", len(api.keys))
+	}
+}
+
+func TestCreateIdempotently_ClientError(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/monitors/query":
+			_, _ = w.Write([]byte(`{"data":[]}`))
+		case "/v1/monitors":
+			w.WriteHeader(http.StatusBadRequest)
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+	client := &TsugaClient{BaseURL: server.URL, client: server.Client()}
+
+	httpResp, err := client.createIdempotently(context.Background(), "/v1/monitors", map[string]interface{}{"name": "latency", "owner": "team-1"})
+	if err != nil {
+		t.Fatalf("createIdempotently() error = %v", err)
+	}
+	defer func() { _ = httpResp.Body.Close() }()
+	if httpResp.StatusCode != http.StatusBadRequest {
+		t.Errorf("createIdempotently() status = %d, want the 400 unchanged", httpResp.StatusCode)
+	}
+}
@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"terraform-provider-tsuga/internal/resource_ingestion_api_key"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResource = (*ingestionApiKeyEphemeralResource)(nil)
+var _ ephemeral.EphemeralResourceWithConfigure = (*ingestionApiKeyEphemeralResource)(nil)
+var _ ephemeral.EphemeralResourceWithClose = (*ingestionApiKeyEphemeralResource)(nil)
+
+// ingestionApiKeyPrivateID is the private data key holding the ID of a key
+// to delete when the ephemeral resource is closed.
+const ingestionApiKeyPrivateID = "delete_id"
+
+func NewIngestionApiKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &ingestionApiKeyEphemeralResource{}
+}
+
+// ingestionApiKeyEphemeralResource creates ingestion API keys whose secret is
+// only ever held in memory, for passing to write-only attributes.
+type ingestionApiKeyEphemeralResource struct {
+	client *TsugaClient
+}
+
+type ingestionApiKeyEphemeralModel struct {
+	Id                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Owner              types.String `tfsdk:"owner"`
+	KeyLastCharacters  types.String `tfsdk:"key_last_characters"`
+	Key                types.String `tfsdk:"key"`
+	Tags               types.List   `tfsdk:"tags"`
+	TeamOverrideFields types.List   `tfsdk:"team_override_fields"`
+	DeleteOnClose      types.Bool   `tfsdk:"delete_on_close"`
+}
+
+func (r *ingestionApiKeyEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*TsugaClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *TsugaClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ingestionApiKeyEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ingestion_api_key"
+}
+
+func (r *ingestionApiKeyEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates an ingestion API key without storing it in state or plan files, for use within the run itself, such as by a provisioner or in a provider configuration. " +
+			"Terraform opens ephemeral resources on every plan and apply once their arguments are known, and each open creates a new key, " +
+			"which is deleted at the end of the run unless `delete_on_close` is `false`. " +
+			"Writing the key to a write-only attribute of another resource is not supported: the key written by an apply is deleted when the apply ends, and keeping it instead leaves a new key behind on every plan and apply. " +
+			"Use the `tsuga_ingestion_api_key` resource with `persist_key = false` and `pgp_key` or `age_recipient` to hand a long-lived key off without storing it in plaintext.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Tsuga-generated ingestion API key ID.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Kebab-case name for the ingestion API key. Maximum length is 100 characters.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			"owner": schema.StringAttribute{
+				Required:    true,
+				Description: "Team ID that will own and manage the ingestion API key.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 250),
+				},
+			},
+			"tags": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Required:    true,
+							Description: "Tag key to attach to the resource.",
+							Validators: []validator.String{
+								stringvalidator.LengthAtMost(128),
+							},
+						},
+						"value": schema.StringAttribute{
+							Required:    true,
+							Description: "Tag value to attach to the resource. Leading or trailing whitespace is rejected.",
+							Validators: []validator.String{
+								stringvalidator.LengthAtMost(256),
+								stringvalidator.RegexMatches(regexp.MustCompile(`^\S(.*\S)?$`), ""),
+							},
+						},
+					},
+					CustomType: resource_ingestion_api_key.TagsType{
+						ObjectType: types.ObjectType{
+							AttrTypes: resource_ingestion_api_key.TagsValue{}.AttributeTypes(ctx),
+						},
+					},
+				},
+				Required:    true,
+				Description: "Key/value tags to apply to the resource. Tag policies may require specific keys or values.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(50),
+				},
+			},
+			"team_override_fields": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Array form. Up to 3 fields are allowed.",
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 3),
+				},
+			},
+			"delete_on_close": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Delete the key when Terraform closes the ephemeral resource at the end of the run. Defaults to `true`. With `false`, every plan and apply leaves a new key behind, which must be deleted outside Terraform.",
+			},
+			"key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The full API key value.",
+			},
+			"key_last_characters": schema.StringAttribute{
+				Computed:    true,
+				Description: "Last visible characters of the secret key. Use this to identify a key without exposing the full secret.",
+			},
+		},
+	}
+}
+
+func (r *ingestionApiKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config ingestionApiKeyEphemeralModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := ingestionApiKeyRequestBody(ctx, ingestionApiKeyModel{
+		Name:               config.Name,
+		Owner:              config.Owner,
+		Tags:               config.Tags,
+		TeamOverrideFields: config.TeamOverrideFields,
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, diags := createIngestionApiKey(ctx, r.client, body)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.Id = types.StringValue(apiResp.Data.ID)
+	config.Key = types.StringValue(apiResp.Data.Key)
+	config.KeyLastCharacters = types.StringValue(apiResp.Data.KeyLastCharacters)
+
+	if config.DeleteOnClose.IsNull() || config.DeleteOnClose.ValueBool() {
+		id, err := json.Marshal(apiResp.Data.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to encode ingestion API key ID: %s", err))
+			return
+		}
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, ingestionApiKeyPrivateID, id)...)
+	} else {
+		resp.Diagnostics.AddWarning(
+			"Ingestion API Key Kept After the Run",
+			fmt.Sprintf("delete_on_close is false, so the ingestion API key %s is not deleted when the run ends. Terraform opens the ephemeral resource on every plan and apply, and each open creates a new key: delete the keys no longer in use outside Terraform.", apiResp.Data.ID),
+		)
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &config)...)
+}
+
+// Close deletes the key created by Open unless delete_on_close is false. Keys
+// created with it outlive the run and are managed outside Terraform.
+func (r *ingestionApiKeyEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	raw, diags := req.Private.GetKey(ctx, ingestionApiKeyPrivateID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || raw == nil {
+		return
+	}
+
+	var id string
+	if err := json.Unmarshal(raw, &id); err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to decode ingestion API key ID: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(deleteIngestionApiKey(ctx, r.client, id)...)
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccIngestionApiKeyEphemeralResource(t *testing.T) {
+	teamName := fmt.Sprintf("test-%s", randomString(10))
+	keyName := fmt.Sprintf("test-%s", randomString(10))
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"tsuga": testAccProtoV6ProviderFactories["tsuga"],
+			"echo":  echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "tsuga_team" "test" {
+  name       = "%s"
+  visibility = "public"
+}
+
+ephemeral "tsuga_ingestion_api_key" "test" {
+  name            = "%s"
+  owner           = tsuga_team.test.id
+  delete_on_close = true
+  tags = [
+    {
+      key   = "env"
+      value = "dev"
+    }
+  ]
+}
+
+provider "echo" {
+  data = {
+    name       = ephemeral.tsuga_ingestion_api_key.test.name
+    key_is_set = ephemeral.tsuga_ingestion_api_key.test.key != ""
+  }
+}
+
+resource "echo" "test" {}
+`, teamName, keyName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("name"), knownvalue.StringExact(keyName)),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("key_is_set"), knownvalue.Bool(true)),
+				},
+			},
+		},
+	})
+}
@@ -6,9 +6,14 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"os"
+	"time"
 
+	"terraform-provider-tsuga/internal/keyencrypt"
 	"terraform-provider-tsuga/internal/resource_ingestion_api_key"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -16,12 +21,19 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ resource.Resource = (*ingestionApiKeyResource)(nil)
 var _ resource.ResourceWithConfigure = (*ingestionApiKeyResource)(nil)
 var _ resource.ResourceWithImportState = (*ingestionApiKeyResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*ingestionApiKeyResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*ingestionApiKeyResource)(nil)
+
+// keybaseDirEnv names the directory holding <username>.asc public keys for
+// keybase:<username> references in `pgp_key`.
+const keybaseDirEnv = "TSUGA_KEYBASE_DIR"
 
 func NewIngestionApiKeyResource() resource.Resource {
 	return &ingestionApiKeyResource{}
@@ -33,15 +45,28 @@ type ingestionApiKeyResource struct {
 
 // ingestionApiKeyModel is the Terraform state model. It mirrors
 // resource_ingestion_api_key.IngestionApiKeyModel but adds the `key` field,
-// which the API only returns on creation.
+// which the API only returns on creation, `persist_key`, which controls
+// whether that value is kept in state, and the attributes encrypting and
+// rotating it.
 type ingestionApiKeyModel struct {
-	Id                 types.String `tfsdk:"id"`
-	Name               types.String `tfsdk:"name"`
-	Owner              types.String `tfsdk:"owner"`
-	KeyLastCharacters  types.String `tfsdk:"key_last_characters"`
-	Key                types.String `tfsdk:"key"`
-	Tags               types.List   `tfsdk:"tags"`
-	TeamOverrideFields types.List   `tfsdk:"team_override_fields"`
+	Id                 types.String   `tfsdk:"id"`
+	Name               types.String   `tfsdk:"name"`
+	Owner              types.String   `tfsdk:"owner"`
+	KeyLastCharacters  types.String   `tfsdk:"key_last_characters"`
+	Key                types.String   `tfsdk:"key"`
+	PersistKey         types.Bool     `tfsdk:"persist_key"`
+	PgpKey             types.String   `tfsdk:"pgp_key"`
+	AgeRecipient       types.String   `tfsdk:"age_recipient"`
+	EncryptedKey       types.String   `tfsdk:"encrypted_key"`
+	KeyFingerprint     types.String   `tfsdk:"key_fingerprint"`
+	RotationPeriod     types.String   `tfsdk:"rotation_period"`
+	RotationOverlap    types.String   `tfsdk:"rotation_overlap"`
+	RotatedAt          types.String   `tfsdk:"rotated_at"`
+	NextRotationAt     types.String   `tfsdk:"next_rotation_at"`
+	PreviousKeyId      types.String   `tfsdk:"previous_key_id"`
+	Tags               types.List     `tfsdk:"tags"`
+	TeamOverrideFields types.List     `tfsdk:"team_override_fields"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 type ingestionApiKeyAPIResponse struct {
@@ -78,66 +103,265 @@ func (r *ingestionApiKeyResource) Metadata(_ context.Context, req resource.Metad
 }
 
 func (r *ingestionApiKeyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	base := resource_ingestion_api_key.IngestionApiKeyResourceSchema(ctx)
+	base := withStableID(resource_ingestion_api_key.IngestionApiKeyResourceSchema(ctx))
 	base.Description = "An ingestion API key used to send telemetry data to Tsuga."
+	// ModifyPlan marks the key's identity unknown again when it plans a rotation.
+	keyLastCharacters := base.Attributes["key_last_characters"].(schema.StringAttribute)
+	keyLastCharacters.PlanModifiers = []planmodifier.String{
+		stringplanmodifier.UseStateForUnknown(),
+	}
+	base.Attributes["key_last_characters"] = keyLastCharacters
 	base.Attributes["key"] = schema.StringAttribute{
 		Computed:  true,
 		Sensitive: true,
 		PlanModifiers: []planmodifier.String{
 			stringplanmodifier.UseStateForUnknown(),
 		},
-		Description: "The full API key value. Only available at creation time; not retrievable afterwards.",
+		Description: "The full API key value. Only available at creation time; not retrievable afterwards. Null when `persist_key` is `false` or the key is encrypted with `pgp_key` or `age_recipient`.",
+	}
+	base.Attributes["persist_key"] = schema.BoolAttribute{
+		Optional:    true,
+		Description: "Whether to store the full `key` in state. Defaults to `true`. Set to `false` to keep the secret out of state entirely: the key is then only identifiable through `key_last_characters`. Switching to `false` removes a stored key from state; switching back cannot recover it. To create a key used only within the run, such as by a provisioner, use the `tsuga_ingestion_api_key` ephemeral resource.",
+	}
+	base.Attributes["pgp_key"] = schema.StringAttribute{
+		Optional: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			stringvalidator.ConflictsWith(path.MatchRoot("age_recipient")),
+		},
+		Description: "PGP public key to encrypt the key with, exposed as `encrypted_key` instead of the plaintext `key`. Either an ASCII-armored key, a base64-encoded binary key, or `keybase:<username>`, read from `<username>.asc` in the directory named by the `" + keybaseDirEnv + "` environment variable (default `keybase`). Decrypt with `base64 --decode | gpg --decrypt`. Changing it creates a new key, since the API only returns the secret on creation.",
+	}
+	base.Attributes["age_recipient"] = schema.StringAttribute{
+		Optional: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Description: "age X25519 recipient (`age1…`) to encrypt the key to, exposed as `encrypted_key` instead of the plaintext `key`. Decrypt with `base64 --decode | age --decrypt -i <identity file>`. Changing it creates a new key, since the API only returns the secret on creation.",
+	}
+	base.Attributes["encrypted_key"] = schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		Description: "The key encrypted with `pgp_key` or `age_recipient`, base64-encoded. Null when neither is set.",
+	}
+	base.Attributes["key_fingerprint"] = schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		Description: "Fingerprint of the PGP key used to encrypt `encrypted_key`, or the age recipient. Null when neither is set.",
+	}
+	base.Attributes["rotation_period"] = schema.StringAttribute{
+		Optional:    true,
+		Description: "Rotate the key once this long has passed since `rotated_at`, such as `90d` or `720h` (units `d`, `h`, `m` and `s`). The first plan after the period shows the key replaced in place by a new one, with a new `id` and `key`. Imported keys, and keys created before rotation was tracked, start their first period at the apply that enables it, which sets `rotated_at`.",
+	}
+	base.Attributes["rotation_overlap"] = schema.StringAttribute{
+		Optional:    true,
+		Description: "How long the replaced key stays valid after a rotation, tracked as `previous_key_id`, so that clients can switch over. It is deleted by the first apply after the overlap. Must be shorter than `rotation_period`. When unset, the replaced key is deleted as part of the rotation.",
+	}
+	base.Attributes["rotated_at"] = schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		Description: "When the current key was created, in RFC 3339 format.",
 	}
-	resp.Schema = base
+	base.Attributes["next_rotation_at"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "When the current key is due for rotation, in RFC 3339 format. Null when `rotation_period` is unset. Rotation happens on the first apply after this date.",
+	}
+	base.Attributes["previous_key_id"] = schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		Description: "ID of the key replaced by the last rotation while it stays valid during `rotation_overlap`. Null otherwise.",
+	}
+	resp.Schema = withTimeouts(ctx, base)
 }
 
-func (r *ingestionApiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+func (r *ingestionApiKeyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ingestionApiKeyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.PgpKey.IsUnknown() && !config.AgeRecipient.IsUnknown() {
+		if _, err := ingestionApiKeyRecipient(config); err != nil {
+			attribute := "pgp_key"
+			if !config.AgeRecipient.IsNull() {
+				attribute = "age_recipient"
+			}
+			resp.Diagnostics.AddAttributeError(path.Root(attribute), "Invalid encryption key", fmt.Sprintf("%s: %s", attribute, err))
+		}
+	}
+
+	if config.RotationPeriod.IsUnknown() || config.RotationOverlap.IsUnknown() {
+		return
+	}
+	var period, overlap time.Duration
+	if !config.RotationPeriod.IsNull() {
+		d, err := parseDuration(config.RotationPeriod.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("rotation_period"), "Invalid rotation period", fmt.Sprintf("rotation_period: %s", err))
+			return
+		}
+		period = d
+	}
+	if !config.RotationOverlap.IsNull() {
+		d, err := parseDuration(config.RotationOverlap.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("rotation_overlap"), "Invalid rotation overlap", fmt.Sprintf("rotation_overlap: %s", err))
+			return
+		}
+		overlap = d
+	}
+	if overlap >= period && period > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rotation_overlap"),
+			"Invalid rotation overlap",
+			fmt.Sprintf("rotation_overlap (%s) must be shorter than rotation_period (%s), so that the previous key is deleted before the next rotation.", config.RotationOverlap.ValueString(), config.RotationPeriod.ValueString()),
+		)
+	}
 }
 
-func (r *ingestionApiKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan ingestionApiKeyModel
+// ModifyPlan plans `key` as null when it is not stored in plaintext: when
+// `persist_key` is false, so turning the option off on an existing key drops the
+// stored value instead of preserving it, and when the key is encrypted. It also
+// plans rotations: once `rotation_period` has passed since `rotated_at`, the key
+// is replaced by a new one, and the key it replaced is deleted after
+// `rotation_overlap`. `rotated_at` is unknown in the plan when rotating, and
+// when enabling rotation on a key whose creation time is not known.
+func (r *ingestionApiKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
 
+	var plan ingestionApiKeyModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	body, diags := r.modelToRequest(ctx, plan)
-	resp.Diagnostics.Append(diags...)
+	dropKey := !plan.PersistKey.IsUnknown() && !persistKey(plan)
+	encrypted := !plan.PgpKey.IsNull() || !plan.AgeRecipient.IsNull()
+	if dropKey || encrypted {
+		plan.Key = types.StringNull()
+	}
+
+	if req.State.Raw.IsNull() {
+		plan.PreviousKeyId = types.StringNull()
+		if plan.RotationPeriod.IsNull() {
+			plan.NextRotationAt = types.StringNull()
+		}
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	var state ingestionApiKeyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	httpResp, err := r.client.doRequest(ctx, http.MethodPost, "/v1/ingestion-api-keys", body)
+	plan.RotatedAt = state.RotatedAt
+	if plan.RotationPeriod.IsUnknown() || plan.RotationOverlap.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+	period, overlap := rotationSettings(plan)
+
+	now := time.Now().UTC()
+	rotatedAt, err := time.Parse(time.RFC3339, state.RotatedAt.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create ingestion API key: %s", err))
+		// Keys created before rotation was tracked, or imported, start their
+		// first period at the apply that enables rotation, which records it.
+		if period > 0 {
+			plan.RotatedAt = types.StringUnknown()
+			plan.NextRotationAt = types.StringUnknown()
+		} else {
+			plan.NextRotationAt = types.StringNull()
+		}
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
 		return
 	}
-	defer func() { _ = httpResp.Body.Close() }()
 
-	if err := r.client.checkResponse(httpResp); err != nil {
-		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to create ingestion API key: %s", err))
+	rotation := planKeyRotation(rotatedAt, !state.PreviousKeyId.IsNull(), period, overlap, now)
+	if rotation.Rotate {
+		plan.Id = types.StringUnknown()
+		plan.KeyLastCharacters = types.StringUnknown()
+		plan.RotatedAt = types.StringUnknown()
+		plan.NextRotationAt = types.StringUnknown()
+		if !plan.Key.IsNull() {
+			plan.Key = types.StringUnknown()
+		}
+		if encrypted {
+			plan.EncryptedKey = types.StringUnknown()
+		}
+		plan.PreviousKeyId = types.StringNull()
+		if overlap > 0 {
+			plan.PreviousKeyId = state.Id
+		}
+	} else {
+		plan.NextRotationAt = types.StringNull()
+		if !rotation.NextRotationAt.IsZero() {
+			plan.NextRotationAt = types.StringValue(rotation.NextRotationAt.Format(time.RFC3339))
+		}
+		if rotation.RetirePrevious {
+			plan.PreviousKeyId = types.StringNull()
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *ingestionApiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *ingestionApiKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ingestionApiKeyModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	raw, err := io.ReadAll(httpResp.Body)
-	if err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to read response body: %s", err))
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var apiResp ingestionApiKeyAPIResponse
-	if err := json.Unmarshal(raw, &apiResp); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err))
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	body, diags := ingestionApiKeyRequestBody(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	resp.Diagnostics.Append(r.apiRespToModel(ctx, &plan, apiResp)...)
+	apiResp, diags := createIngestionApiKey(ctx, r.client, body)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Errors from here on, such as a failure to encrypt the key, are reported
+	// after the key exists: still record it, so that it is tainted and replaced
+	// on the next apply rather than left behind.
+	resp.Diagnostics.Append(r.apiRespToModel(ctx, &plan, apiResp)...)
+	if !persistKey(plan) {
+		plan.Key = types.StringNull()
+	}
+	setRotatedAt(&plan, time.Now().UTC())
+	plan.PreviousKeyId = types.StringNull()
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -149,6 +373,15 @@ func (r *ingestionApiKeyResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	apiPath := fmt.Sprintf("/v1/ingestion-api-keys/%s", state.Id.ValueString())
 	httpResp, err := r.client.doRequest(ctx, http.MethodGet, apiPath, nil)
 	if err != nil {
@@ -200,12 +433,26 @@ func (r *ingestionApiKeyResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	body, diags := r.modelToRequest(ctx, plan)
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	body, diags := ingestionApiKeyRequestBody(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if plan.Id.IsUnknown() {
+		r.rotate(ctx, body, plan, state, resp)
+		return
+	}
+
 	apiPath := fmt.Sprintf("/v1/ingestion-api-keys/%s", state.Id.ValueString())
 	httpResp, err := r.client.doRequest(ctx, http.MethodPut, apiPath, body)
 	if err != nil {
@@ -238,8 +485,70 @@ func (r *ingestionApiKeyResource) Update(ctx context.Context, req resource.Updat
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if !persistKey(plan) {
+		plan.Key = types.StringNull()
+	}
+	if plan.RotatedAt.IsUnknown() {
+		// Rotation is enabled on a key created before it was tracked, or
+		// imported: its first period starts now.
+		setRotatedAt(&plan, time.Now().UTC())
+	}
+	if plan.NextRotationAt.IsUnknown() {
+		// The rotation settings were unknown at plan time.
+		plan.NextRotationAt = types.StringNull()
+		rotatedAt, err := time.Parse(time.RFC3339, plan.RotatedAt.ValueString())
+		if period, _ := rotationSettings(plan); period > 0 && err == nil {
+			plan.NextRotationAt = types.StringValue(rotatedAt.Add(period).Format(time.RFC3339))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.PreviousKeyId.IsNull() && plan.PreviousKeyId.IsNull() {
+		resp.Diagnostics.Append(deleteIngestionApiKey(ctx, r.client, state.PreviousKeyId.ValueString())...)
+	}
+}
+
+// rotate replaces the key in state by a new one created from body. The replaced
+// key is kept as previous_key_id when planned so, and deleted otherwise, as is a
+// key still kept from an earlier rotation.
+func (r *ingestionApiKeyResource) rotate(ctx context.Context, body map[string]interface{}, plan, state ingestionApiKeyModel, resp *resource.UpdateResponse) {
+	apiResp, diags := createIngestionApiKey(ctx, r.client, body)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var retired []string
+	if !state.PreviousKeyId.IsNull() {
+		retired = append(retired, state.PreviousKeyId.ValueString())
+	}
+	if plan.PreviousKeyId.IsNull() {
+		retired = append(retired, state.Id.ValueString())
+	}
+
+	modelDiags := r.apiRespToModel(ctx, &plan, apiResp)
+	resp.Diagnostics.Append(modelDiags...)
+	if !persistKey(plan) {
+		plan.Key = types.StringNull()
+	}
+	setRotatedAt(&plan, time.Now().UTC())
+	if modelDiags.HasError() {
+		// The new key is recorded to be tainted and replaced; keep the one it
+		// was meant to replace until then.
+		plan.PreviousKeyId = state.Id
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, id := range retired {
+		resp.Diagnostics.Append(deleteIngestionApiKey(ctx, r.client, id)...)
+	}
 }
 
 func (r *ingestionApiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -250,23 +559,74 @@ func (r *ingestionApiKeyResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
-	apiPath := fmt.Sprintf("/v1/ingestion-api-keys/%s", state.Id.ValueString())
-	httpResp, err := r.client.doRequest(ctx, http.MethodDelete, apiPath, map[string]interface{}{})
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete ingestion API key: %s", err))
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(deleteIngestionApiKey(ctx, r.client, state.Id.ValueString())...)
+	if !state.PreviousKeyId.IsNull() {
+		resp.Diagnostics.Append(deleteIngestionApiKey(ctx, r.client, state.PreviousKeyId.ValueString())...)
+	}
+}
+
+// createIngestionApiKey creates a key and returns the API response, the only
+// one that includes the full key.
+func createIngestionApiKey(ctx context.Context, client *TsugaClient, body map[string]interface{}) (ingestionApiKeyAPIResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var apiResp ingestionApiKeyAPIResponse
+
+	httpResp, err := client.doRequest(ctx, http.MethodPost, "/v1/ingestion-api-keys", body)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to create ingestion API key: %s", err))
+		return apiResp, diags
+	}
+	defer func() { _ = httpResp.Body.Close() }()
+
+	if err := client.checkResponse(httpResp); err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Unable to create ingestion API key: %s", err))
+		return apiResp, diags
+	}
+
+	raw, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		diags.AddError("Parse Error", fmt.Sprintf("Unable to read response body: %s", err))
+		return apiResp, diags
+	}
+
+	if err := json.Unmarshal(raw, &apiResp); err != nil {
+		diags.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err))
+	}
+	return apiResp, diags
+}
+
+// deleteIngestionApiKey deletes a key, treating one that no longer exists as deleted.
+func deleteIngestionApiKey(ctx context.Context, client *TsugaClient, id string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	apiPath := fmt.Sprintf("/v1/ingestion-api-keys/%s", id)
+	httpResp, err := client.doRequest(ctx, http.MethodDelete, apiPath, map[string]interface{}{})
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to delete ingestion API key %s: %s", id, err))
+		return diags
+	}
 	defer func() { _ = httpResp.Body.Close() }()
 
 	if httpResp.StatusCode != http.StatusNotFound {
-		if err := r.client.checkResponse(httpResp); err != nil {
-			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to delete ingestion API key: %s", err))
-			return
+		if err := client.checkResponse(httpResp); err != nil {
+			diags.AddError("API Error", fmt.Sprintf("Unable to delete ingestion API key %s: %s", id, err))
 		}
 	}
+	return diags
 }
 
-func (r *ingestionApiKeyResource) modelToRequest(ctx context.Context, model ingestionApiKeyModel) (map[string]interface{}, diag.Diagnostics) {
+// ingestionApiKeyRequestBody builds the create and update request body. It is shared
+// with the ephemeral resource, which creates keys from the same attributes.
+func ingestionApiKeyRequestBody(ctx context.Context, model ingestionApiKeyModel) (map[string]interface{}, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	body := map[string]interface{}{
@@ -305,6 +665,49 @@ func (r *ingestionApiKeyResource) modelToRequest(ctx context.Context, model inge
 	return body, diags
 }
 
+// persistKey reports whether the full key is kept in state; a null
+// `persist_key` means the default, true.
+func persistKey(model ingestionApiKeyModel) bool {
+	return model.PersistKey.IsNull() || model.PersistKey.ValueBool()
+}
+
+// ingestionApiKeyRecipient returns the public key configured to encrypt the key
+// with, or nil when the key is stored in plaintext.
+func ingestionApiKeyRecipient(model ingestionApiKeyModel) (keyencrypt.Recipient, error) {
+	switch {
+	case !model.PgpKey.IsNull():
+		keybaseDir := os.Getenv(keybaseDirEnv)
+		if keybaseDir == "" {
+			keybaseDir = "keybase"
+		}
+		return keyencrypt.PGP(model.PgpKey.ValueString(), keybaseDir)
+	case !model.AgeRecipient.IsNull():
+		return keyencrypt.Age(model.AgeRecipient.ValueString())
+	}
+	return nil, nil
+}
+
+// rotationSettings returns the rotation period and overlap, zero when unset.
+// The values are checked by ValidateConfig.
+func rotationSettings(model ingestionApiKeyModel) (period, overlap time.Duration) {
+	if !model.RotationPeriod.IsNull() {
+		period, _ = parseDuration(model.RotationPeriod.ValueString())
+	}
+	if !model.RotationOverlap.IsNull() {
+		overlap, _ = parseDuration(model.RotationOverlap.ValueString())
+	}
+	return period, overlap
+}
+
+// setRotatedAt records that the current key was created at now.
+func setRotatedAt(model *ingestionApiKeyModel, now time.Time) {
+	model.RotatedAt = types.StringValue(now.Format(time.RFC3339))
+	model.NextRotationAt = types.StringNull()
+	if period, _ := rotationSettings(*model); period > 0 {
+		model.NextRotationAt = types.StringValue(now.Add(period).Format(time.RFC3339))
+	}
+}
+
 func (r *ingestionApiKeyResource) apiRespToModel(ctx context.Context, model *ingestionApiKeyModel, apiResp ingestionApiKeyAPIResponse) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -315,6 +718,23 @@ func (r *ingestionApiKeyResource) apiRespToModel(ctx context.Context, model *ing
 
 	if apiResp.Data.Key != "" {
 		model.Key = types.StringValue(apiResp.Data.Key)
+		model.EncryptedKey = types.StringNull()
+		model.KeyFingerprint = types.StringNull()
+
+		recipient, err := ingestionApiKeyRecipient(*model)
+		if err != nil {
+			model.Key = types.StringNull()
+			diags.AddError("Encryption Error", fmt.Sprintf("Unable to encrypt ingestion API key: %s", err))
+		} else if recipient != nil {
+			model.Key = types.StringNull()
+			encrypted, err := recipient.Encrypt(apiResp.Data.Key)
+			if err != nil {
+				diags.AddError("Encryption Error", fmt.Sprintf("Unable to encrypt ingestion API key: %s", err))
+			} else {
+				model.EncryptedKey = types.StringValue(encrypted)
+				model.KeyFingerprint = types.StringValue(recipient.Fingerprint())
+			}
+		}
 	}
 
 	// Tags
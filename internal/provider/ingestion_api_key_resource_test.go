@@ -1,10 +1,20 @@
 package provider
 
 import (
+	"bytes"
+	"encoding/base64"
 	"fmt"
+	"io"
+	"regexp"
 	"testing"
+	"time"
 
+	"filippo.io/age"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
 
 func TestAccIngestionApiKeyResource(t *testing.T) {
@@ -43,12 +53,13 @@ resource "tsuga_ingestion_api_key" "test" {
 					resource.TestCheckResourceAttr("tsuga_ingestion_api_key.test", "tags.0.value", "dev"),
 				),
 			},
-			// ImportState — key is not returned by GET so it will be empty after import
+			// ImportState — key is not returned by GET so it will be empty after import,
+			// and the creation time of an imported key is unknown
 			{
 				ResourceName:            "tsuga_ingestion_api_key.test",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"key"},
+				ImportStateVerifyIgnore: []string{"key", "rotated_at"},
 			},
 			// Update name and add tags
 			{
@@ -78,6 +89,258 @@ resource "tsuga_ingestion_api_key" "test" {
 					resource.TestCheckResourceAttrSet("tsuga_ingestion_api_key.test", "key"),
 				),
 			},
+			// Stop persisting the key
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "tsuga_team" "test" {
+  name       = "%s"
+  visibility = "public"
+}
+
+resource "tsuga_ingestion_api_key" "test" {
+  name        = "%s-updated"
+  owner       = tsuga_team.test.id
+  persist_key = false
+
+  tags = [
+    {
+      key   = "env"
+      value = "dev"
+    }
+  ]
+}
+`, teamName, keyName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("tsuga_ingestion_api_key.test", "persist_key", "false"),
+					resource.TestCheckNoResourceAttr("tsuga_ingestion_api_key.test", "key"),
+					resource.TestCheckResourceAttrSet("tsuga_ingestion_api_key.test", "key_last_characters"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIngestionApiKeyResource_encrypted(t *testing.T) {
+	teamName := fmt.Sprintf("test-%s", randomString(10))
+	keyName := fmt.Sprintf("test-%s", randomString(10))
+
+	identity, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := func(encryption string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "tsuga_team" "test" {
+  name       = "%s"
+  visibility = "public"
+}
+
+resource "tsuga_ingestion_api_key" "test" {
+  name  = "%s"
+  owner = tsuga_team.test.id
+  %s
+  tags = [
+    {
+      key   = "env"
+      value = "dev"
+    }
+  ]
+}
+`, teamName, keyName, encryption)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config(`pgp_key = "keybase:nobody"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`resolving keybase:nobody`),
+			},
+			// The rotation settings are checked while the recipient is unknown
+			{
+				Config: config(`age_recipient    = tsuga_team.test.id
+  rotation_period  = "1h"
+  rotation_overlap = "2h"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`must be shorter than rotation_period`),
+			},
+			{
+				Config: config(fmt.Sprintf("age_recipient = %q", identity.Recipient())),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("tsuga_ingestion_api_key.test", "key"),
+					resource.TestCheckResourceAttr("tsuga_ingestion_api_key.test", "key_fingerprint", identity.Recipient().String()),
+					resource.TestCheckResourceAttrWith("tsuga_ingestion_api_key.test", "encrypted_key", func(value string) error {
+						raw, err := base64.StdEncoding.DecodeString(value)
+						if err != nil {
+							return err
+						}
+						r, err := age.Decrypt(bytes.NewReader(raw), identity)
+						if err != nil {
+							return err
+						}
+						key, err := io.ReadAll(r)
+						if err != nil {
+							return err
+						}
+						if len(key) == 0 {
+							return fmt.Errorf("decrypted an empty key")
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIngestionApiKeyResource_rotation(t *testing.T) {
+	teamName := fmt.Sprintf("test-%s", randomString(10))
+	keyName := fmt.Sprintf("test-%s", randomString(10))
+
+	config := providerConfig + fmt.Sprintf(`
+resource "tsuga_team" "test" {
+  name       = "%s"
+  visibility = "public"
+}
+
+resource "tsuga_ingestion_api_key" "test" {
+  name             = "%s"
+  owner            = tsuga_team.test.id
+  rotation_period  = "30s"
+  rotation_overlap = "10s"
+  tags = [
+    {
+      key   = "env"
+      value = "dev"
+    }
+  ]
+}
+`, teamName, keyName)
+
+	var firstID string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("tsuga_ingestion_api_key.test", "rotated_at"),
+					resource.TestCheckResourceAttrSet("tsuga_ingestion_api_key.test", "next_rotation_at"),
+					resource.TestCheckNoResourceAttr("tsuga_ingestion_api_key.test", "previous_key_id"),
+					resource.TestCheckResourceAttrWith("tsuga_ingestion_api_key.test", "id", func(value string) error {
+						firstID = value
+						return nil
+					}),
+				),
+			},
+			// Rotation is due: a new key replaces the first one, which is kept during the overlap
+			{
+				PreConfig: func() { time.Sleep(31 * time.Second) },
+				Config:    config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("tsuga_ingestion_api_key.test", "previous_key_id", func(value string) error {
+						if value != firstID {
+							return fmt.Errorf("previous_key_id = %s, want %s", value, firstID)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttrWith("tsuga_ingestion_api_key.test", "id", func(value string) error {
+						if value == firstID {
+							return fmt.Errorf("id was not rotated")
+						}
+						return nil
+					}),
+				),
+			},
+			// The overlap has passed: the first key is deleted
+			{
+				PreConfig: func() { time.Sleep(11 * time.Second) },
+				Config:    config,
+				Check:     resource.TestCheckNoResourceAttr("tsuga_ingestion_api_key.test", "previous_key_id"),
+			},
+		},
+	})
+}
+
+func TestAccIngestionApiKeyResource_rotationAfterImport(t *testing.T) {
+	teamName := fmt.Sprintf("test-%s", randomString(10))
+	keyName := fmt.Sprintf("test-%s", randomString(10))
+
+	// The key created by tsuga_ingestion_api_key.test is imported as
+	// tsuga_ingestion_api_key.imported, whose creation time is then unknown.
+	config := func(imported string) string {
+		return providerConfig + fmt.Sprintf(`
+resource "tsuga_team" "test" {
+  name       = "%[1]s"
+  visibility = "public"
+}
+
+resource "tsuga_ingestion_api_key" "test" {
+  name  = "%[2]s"
+  owner = tsuga_team.test.id
+  tags  = [{ key = "env", value = "dev" }]
+}
+%[3]s`, teamName, keyName, imported)
+	}
+	importedKey := func(rotation string) string {
+		return fmt.Sprintf(`
+resource "tsuga_ingestion_api_key" "imported" {
+  name  = "%s"
+  owner = tsuga_team.test.id
+  tags  = [{ key = "env", value = "dev" }]
+  %s
+}
+`, keyName, rotation)
+	}
+
+	var keyID string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(""),
+				Check: resource.TestCheckResourceAttrWith("tsuga_ingestion_api_key.test", "id", func(value string) error {
+					keyID = value
+					return nil
+				}),
+			},
+			{
+				Config:             config(importedKey("")),
+				ResourceName:       "tsuga_ingestion_api_key.imported",
+				ImportState:        true,
+				ImportStatePersist: true,
+				ImportStateIdFunc: func(*terraform.State) (string, error) {
+					return keyID, nil
+				},
+			},
+			// Enabling rotation starts the first period at apply time, without
+			// replacing the key.
+			{
+				Config: config(importedKey(`rotation_period = "90d"`)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("tsuga_ingestion_api_key.imported", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("tsuga_ingestion_api_key.imported", tfjsonpath.New("id"), knownvalue.NotNull()),
+						plancheck.ExpectUnknownValue("tsuga_ingestion_api_key.imported", tfjsonpath.New("rotated_at")),
+						plancheck.ExpectUnknownValue("tsuga_ingestion_api_key.imported", tfjsonpath.New("next_rotation_at")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("tsuga_ingestion_api_key.imported", "id", "tsuga_ingestion_api_key.test", "id"),
+					resource.TestCheckResourceAttrSet("tsuga_ingestion_api_key.imported", "rotated_at"),
+					resource.TestCheckResourceAttrSet("tsuga_ingestion_api_key.imported", "next_rotation_at"),
+				),
+			},
+			{
+				Config: config(importedKey(`rotation_period = "90d"`)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
 		},
 	})
 }
@@ -0,0 +1,35 @@
+package provider
+
+import "time"
+
+// keyRotation is what a plan does to a key with rotation settings.
+type keyRotation struct {
+	// Rotate creates a new key to replace the current one.
+	Rotate bool
+	// RetirePrevious deletes the key kept from the last rotation.
+	RetirePrevious bool
+	// NextRotationAt is when the current key is due for rotation, zero when the key
+	// is not rotated or is rotated by this plan, the next date then depending on
+	// when it is applied.
+	NextRotationAt time.Time
+}
+
+// planKeyRotation decides whether a key created at rotatedAt is rotated at now.
+// A zero period disables rotation. The previous key, if any, is retired once the
+// overlap has passed since the rotation that replaced it, and at the latest when
+// the current key is rotated in turn.
+func planKeyRotation(rotatedAt time.Time, hasPrevious bool, period, overlap time.Duration, now time.Time) keyRotation {
+	var plan keyRotation
+	if period > 0 {
+		next := rotatedAt.Add(period)
+		if now.Before(next) {
+			plan.NextRotationAt = next
+		} else {
+			plan.Rotate = true
+		}
+	}
+	if hasPrevious {
+		plan.RetirePrevious = plan.Rotate || !now.Before(rotatedAt.Add(overlap))
+	}
+	return plan
+}
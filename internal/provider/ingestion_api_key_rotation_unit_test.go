@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPlanKeyRotation(t *testing.T) {
+	rotatedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	cases := []struct {
+		name        string
+		hasPrevious bool
+		period      time.Duration
+		overlap     time.Duration
+		now         time.Time
+		want        keyRotation
+	}{
+		{
+			name: "rotation disabled",
+			now:  rotatedAt.Add(1000 * day),
+		},
+		{
+			name:   "not due yet",
+			period: 90 * day,
+			now:    rotatedAt.Add(30 * day),
+			want:   keyRotation{NextRotationAt: rotatedAt.Add(90 * day)},
+		},
+		{
+			name:   "due",
+			period: 90 * day,
+			now:    rotatedAt.Add(90 * day),
+			want:   keyRotation{Rotate: true},
+		},
+		{
+			name:        "previous key within the overlap",
+			hasPrevious: true,
+			period:      90 * day,
+			overlap:     7 * day,
+			now:         rotatedAt.Add(6 * day),
+			want:        keyRotation{NextRotationAt: rotatedAt.Add(90 * day)},
+		},
+		{
+			name:        "previous key after the overlap",
+			hasPrevious: true,
+			period:      90 * day,
+			overlap:     7 * day,
+			now:         rotatedAt.Add(7 * day),
+			want:        keyRotation{RetirePrevious: true, NextRotationAt: rotatedAt.Add(90 * day)},
+		},
+		{
+			name:        "previous key when rotation is disabled",
+			hasPrevious: true,
+			now:         rotatedAt.Add(time.Hour),
+			want:        keyRotation{RetirePrevious: true},
+		},
+		{
+			name:        "previous key still kept at the next rotation",
+			hasPrevious: true,
+			period:      90 * day,
+			overlap:     7 * day,
+			now:         rotatedAt.Add(100 * day),
+			want:        keyRotation{Rotate: true, RetirePrevious: true},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := planKeyRotation(rotatedAt, tc.hasPrevious, tc.period, tc.overlap, tc.now)
+			if got != tc.want {
+				t.Errorf("planKeyRotation() = %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"terraform-provider-tsuga/internal/datasource_inventory_resources"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*inventoryResourcesDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*inventoryResourcesDataSource)(nil)
+
+func NewInventoryResourcesDataSource() datasource.DataSource {
+	return &inventoryResourcesDataSource{}
+}
+
+type inventoryResourcesDataSource struct {
+	client *TsugaClient
+}
+
+func (d *inventoryResourcesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*TsugaClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *TsugaClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *inventoryResourcesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_inventory_resources"
+}
+
+func (d *inventoryResourcesDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_inventory_resources.InventoryResourcesDataSourceSchema(ctx)
+}
+
+func (d *inventoryResourcesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config datasource_inventory_resources.InventoryResourcesModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filters, diags := inventoryResourceFilters(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items := []datasource_inventory_resources.InventoryResourceModel{}
+	for offset := 0; ; offset += listPageSize {
+		body := map[string]interface{}{
+			"limit":   listPageSize,
+			"offset":  offset,
+			"filters": filters,
+		}
+
+		var page inventoryResourcesAPIResponse
+		resp.Diagnostics.Append(d.client.fetchJSON(ctx, http.MethodPost, "/v1/inventory/resources/query", body, "query inventory resources", &page)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for _, r := range page.Data {
+			item, diags := flattenInventoryResource(ctx, r)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			items = append(items, item)
+		}
+		total := page.Metadata.Pagination.TotalCount
+		if len(page.Data) < listPageSize || (total > 0 && offset+len(page.Data) >= total) {
+			break
+		}
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: datasource_inventory_resources.InventoryResourceAttrTypes()}, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config.Resources = list
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// inventoryResourceFilters returns the filters of an inventory query for the
+// filter attributes of config that are set.
+func inventoryResourceFilters(ctx context.Context, config datasource_inventory_resources.InventoryResourcesModel) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	filters := map[string]interface{}{}
+
+	lists := map[string]types.List{
+		"cloudPlatforms":      config.CloudPlatforms,
+		"cloudAccounts":       config.CloudAccounts,
+		"nativeResourceTypes": config.NativeResourceTypes,
+	}
+	for key, list := range lists {
+		if list.IsNull() {
+			continue
+		}
+		values, d := expandStringList(ctx, list)
+		diags.Append(d...)
+		filters[key] = map[string]interface{}{"values": values}
+	}
+	if !config.Search.IsNull() {
+		filters["searchQuery"] = map[string]interface{}{"value": config.Search.ValueString()}
+	}
+
+	return filters, diags
+}
+
+func flattenInventoryResource(ctx context.Context, r inventoryResourceAPIData) (datasource_inventory_resources.InventoryResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tags := map[string]string{}
+	for _, tag := range r.Tags {
+		tags[tag.Key] = tag.Value
+	}
+	tagMap, d := types.MapValueFrom(ctx, types.StringType, tags)
+	diags.Append(d...)
+
+	attributes := types.StringNull()
+	if len(r.Attributes) > 0 && string(r.Attributes) != "null" {
+		var compact bytes.Buffer
+		if err := json.Compact(&compact, r.Attributes); err != nil {
+			diags.AddError("Parse Error", fmt.Sprintf("Unable to parse attributes of inventory resource %s: %s", r.ID, err))
+		}
+		attributes = types.StringValue(compact.String())
+	}
+
+	return datasource_inventory_resources.InventoryResourceModel{
+		Id:                       types.StringValue(r.ID),
+		CloudPlatform:            types.StringValue(r.CloudPlatform),
+		CloudAccount:             types.StringValue(r.CloudAccount),
+		CloudRegion:              stringValueOrNull(r.CloudRegion),
+		FullyQualifiedResourceId: types.StringValue(r.FullyQualifiedResourceID),
+		DisplayName:              types.StringValue(r.DisplayName),
+		NativeResourceType:       types.StringValue(r.NativeResourceType),
+		Category:                 stringValueOrNull(r.Category),
+		ResourceType:             stringValueOrNull(r.ResourceType),
+		ResourceCreatedAt:        stringValueOrNull(r.ResourceCreatedAt),
+		ResourceUpdatedAt:        stringValueOrNull(r.ResourceUpdatedAt),
+		ResourceLastSeenAt:       types.StringValue(r.ResourceLastSeenAt),
+		Tags:                     tagMap,
+		Attributes:               attributes,
+	}, diags
+}
+
+// inventoryResourceAPIData is an inventory resource: the fields of
+// InventoryResourceBase, and the category, type and type-specific attributes.
+type inventoryResourceAPIData struct {
+	ID                       string `json:"id"`
+	CloudPlatform            string `json:"cloudPlatform"`
+	CloudAccount             string `json:"cloudAccount"`
+	CloudRegion              string `json:"cloudRegion"`
+	FullyQualifiedResourceID string `json:"fullyQualifiedResourceId"`
+	DisplayName              string `json:"displayName"`
+	NativeResourceType       string `json:"nativeResourceType"`
+	ResourceCreatedAt        string `json:"resourceCreatedAt"`
+	ResourceUpdatedAt        string `json:"resourceUpdatedAt"`
+	ResourceLastSeenAt       string `json:"resourceLastSeenAt"`
+	Tags                     []struct {
+		Key   string `json:"key"`
+		Value string `json:"value"`
+	} `json:"tags"`
+	Category     string          `json:"category"`
+	ResourceType string          `json:"resourceType"`
+	Attributes   json.RawMessage `json:"attributes"`
+}
+
+type inventoryResourcesAPIResponse struct {
+	Data     []inventoryResourceAPIData `json:"data"`
+	Metadata apiResponseMetadata        `json:"metadata"`
+}
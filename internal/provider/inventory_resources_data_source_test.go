@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccInventoryResourcesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "tsuga_inventory_resources" "aws" {
+  cloud_platforms = ["aws"]
+}
+
+data "tsuga_inventory_resources" "none" {
+  native_resource_types = ["aws_s3_bucket"]
+  search                = "no-such-bucket-name"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.tsuga_inventory_resources.aws", "resources.0.id"),
+					resource.TestCheckResourceAttr("data.tsuga_inventory_resources.aws", "resources.0.cloud_platform", "aws"),
+					resource.TestCheckResourceAttrSet("data.tsuga_inventory_resources.aws", "resources.0.native_resource_type"),
+					resource.TestCheckResourceAttr("data.tsuga_inventory_resources.none", "resources.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+)
+
+func TestFlattenInventoryResource(t *testing.T) {
+	var r inventoryResourceAPIData
+	raw := `{
+		"id": "r1", "cloudPlatform": "aws", "cloudAccount": "123456789012", "fullyQualifiedResourceId": "arn:aws:ec2:us-east-1:123456789012:instance/i-1",
+		"displayName": "web-1", "nativeResourceType": "aws_ec2_instance", "resourceLastSeenAt": "2026-10-01T00:00:00Z",
+		"tags": [{"key": "env", "value": "prod"}],
+		"category": "compute", "resourceType": "virtualMachine",
+		"attributes": { "instanceType": "t3.micro", "vCpus": 2 }
+	}`
+	if err := json.Unmarshal([]byte(raw), &r); err != nil {
+		t.Fatal(err)
+	}
+
+	item, diags := flattenInventoryResource(context.Background(), r)
+	if diags.HasError() {
+		t.Fatalf("flattenInventoryResource() diagnostics = %v", diags)
+	}
+	if got, want := item.Attributes.ValueString(), `{"instanceType":"t3.micro","vCpus":2}`; got != want {
+		t.Errorf("attributes = %s, want %s", got, want)
+	}
+	if got := item.Tags.Elements()["env"].String(); got != `"prod"` {
+		t.Errorf("tags[env] = %s, want \"prod\"", got)
+	}
+	if !item.CloudRegion.IsNull() || !item.ResourceCreatedAt.IsNull() {
+		t.Errorf("cloud_region = %s and resource_created_at = %s, want both null", item.CloudRegion, item.ResourceCreatedAt)
+	}
+
+	r.Attributes = nil
+	item, _ = flattenInventoryResource(context.Background(), r)
+	if !item.Attributes.IsNull() {
+		t.Errorf("attributes = %s without attributes, want null", item.Attributes)
+	}
+}
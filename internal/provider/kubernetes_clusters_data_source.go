@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"terraform-provider-tsuga/internal/datasource_kubernetes"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*kubernetesClustersDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*kubernetesClustersDataSource)(nil)
+
+func NewKubernetesClustersDataSource() datasource.DataSource {
+	return &kubernetesClustersDataSource{}
+}
+
+type kubernetesClustersDataSource struct {
+	client *TsugaClient
+}
+
+func (d *kubernetesClustersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*TsugaClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *TsugaClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *kubernetesClustersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kubernetes_clusters"
+}
+
+func (d *kubernetesClustersDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_kubernetes.KubernetesClustersDataSourceSchema(ctx)
+}
+
+func (d *kubernetesClustersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config datasource_kubernetes.KubernetesClustersModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiResp kubernetesClustersAPIResponse
+	resp.Diagnostics.Append(d.client.fetchJSON(ctx, http.MethodGet, kubernetesExplorerPath("clusters", config.ClusterId, config.Search), nil, "list Kubernetes clusters", &apiResp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusters := []datasource_kubernetes.KubernetesClusterModel{}
+	names := []string{}
+	for _, c := range apiResp.Data {
+		teams, diags := kubernetesStringList(ctx, c.Teams)
+		resp.Diagnostics.Append(diags...)
+		envs, diags := kubernetesStringList(ctx, c.Envs)
+		resp.Diagnostics.Append(diags...)
+		phases := types.MapNull(types.Int64Type)
+		if c.PodPhaseCounts != nil {
+			phases, diags = types.MapValueFrom(ctx, types.Int64Type, c.PodPhaseCounts)
+			resp.Diagnostics.Append(diags...)
+		}
+		clusters = append(clusters, datasource_kubernetes.KubernetesClusterModel{
+			Name:             types.StringValue(c.Cluster),
+			Teams:            teams,
+			Envs:             envs,
+			ReadyNodes:       types.Int64PointerValue(c.ReadyNodes),
+			PodPhaseCounts:   phases,
+			CpuUsageMilli:    types.Float64PointerValue(c.CpuUsageMilli),
+			MemoryUsageBytes: types.Float64PointerValue(c.MemoryUsageBytes),
+		})
+		names = append(names, c.Cluster)
+	}
+
+	var diags diag.Diagnostics
+	config.Clusters, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: datasource_kubernetes.KubernetesClusterAttrTypes()}, clusters)
+	resp.Diagnostics.Append(diags...)
+	config.Names, diags = types.ListValueFrom(ctx, types.StringType, names)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// kubernetesExplorerPath returns the API path listing a kind of Kubernetes
+// resources, with the clusterId and search query parameters when they are set.
+func kubernetesExplorerPath(resource string, clusterID, search types.String) string {
+	query := url.Values{}
+	if !clusterID.IsNull() {
+		query.Set("clusterId", clusterID.ValueString())
+	}
+	if !search.IsNull() {
+		query.Set("search", search.ValueString())
+	}
+	apiPath := "/v1/kubernetes-explorer/" + resource
+	if len(query) == 0 {
+		return apiPath
+	}
+	return apiPath + "?" + query.Encode()
+}
+
+// kubernetesResourceMatches reports whether a Kubernetes resource is in the
+// cluster and namespace filters, each of which matches anything when null.
+func kubernetesResourceMatches(cluster, namespace string, clusterFilter, namespaceFilter types.String) bool {
+	if !clusterFilter.IsNull() && cluster != clusterFilter.ValueString() {
+		return false
+	}
+	return namespaceFilter.IsNull() || namespace == namespaceFilter.ValueString()
+}
+
+// kubernetesStringList converts the teams or environments of a Kubernetes
+// resource, which the API omits when none is observed, to a list.
+func kubernetesStringList(ctx context.Context, values []string) (types.List, diag.Diagnostics) {
+	if values == nil {
+		values = []string{}
+	}
+	return types.ListValueFrom(ctx, types.StringType, values)
+}
+
+type kubernetesClustersAPIResponse struct {
+	Data []struct {
+		Cluster          string           `json:"cluster"`
+		Teams            []string         `json:"teams"`
+		Envs             []string         `json:"envs"`
+		CpuUsageMilli    *float64         `json:"cpuUsageMilli"`
+		MemoryUsageBytes *float64         `json:"memoryUsageBytes"`
+		ReadyNodes       *int64           `json:"readyNodes"`
+		PodPhaseCounts   map[string]int64 `json:"podPhaseCounts"`
+	} `json:"data"`
+}
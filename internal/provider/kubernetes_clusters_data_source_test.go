@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccKubernetesClustersDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "tsuga_kubernetes_clusters" "all" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.tsuga_kubernetes_clusters.all", "clusters.#"),
+					resource.TestCheckResourceAttrSet("data.tsuga_kubernetes_clusters.all", "names.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesClustersDataSource_InvalidSearch(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "tsuga_kubernetes_clusters" "test" {
+  search = "prod east"
+}
+`,
+				ExpectError: regexp.MustCompile(`must only contain the characters of Kubernetes names`),
+			},
+		},
+	})
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"terraform-provider-tsuga/internal/datasource_kubernetes"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*kubernetesNamespacesDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*kubernetesNamespacesDataSource)(nil)
+
+func NewKubernetesNamespacesDataSource() datasource.DataSource {
+	return &kubernetesNamespacesDataSource{}
+}
+
+type kubernetesNamespacesDataSource struct {
+	client *TsugaClient
+}
+
+func (d *kubernetesNamespacesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*TsugaClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *TsugaClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *kubernetesNamespacesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kubernetes_namespaces"
+}
+
+func (d *kubernetesNamespacesDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_kubernetes.KubernetesNamespacesDataSourceSchema(ctx)
+}
+
+func (d *kubernetesNamespacesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config datasource_kubernetes.KubernetesNamespacesModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiResp kubernetesNamespacesAPIResponse
+	resp.Diagnostics.Append(d.client.fetchJSON(ctx, http.MethodGet, kubernetesExplorerPath("namespaces", config.ClusterId, config.Search), nil, "list Kubernetes namespaces", &apiResp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	namespaces := []datasource_kubernetes.KubernetesNamespaceModel{}
+	names := []string{}
+	seen := map[string]bool{}
+	for _, n := range apiResp.Data {
+		if !config.KubernetesCluster.IsNull() && n.Cluster != config.KubernetesCluster.ValueString() {
+			continue
+		}
+		teams, diags := kubernetesStringList(ctx, n.Teams)
+		resp.Diagnostics.Append(diags...)
+		envs, diags := kubernetesStringList(ctx, n.Envs)
+		resp.Diagnostics.Append(diags...)
+		namespaces = append(namespaces, datasource_kubernetes.KubernetesNamespaceModel{
+			Name:              types.StringValue(n.Namespace),
+			KubernetesCluster: stringValueOrNull(n.Cluster),
+			Teams:             teams,
+			Envs:              envs,
+			Pods:              types.Int64PointerValue(n.Pods),
+			CpuUsageMilli:     types.Float64PointerValue(n.CpuUsageMilli),
+			MemoryUsageBytes:  types.Float64PointerValue(n.MemoryUsageBytes),
+		})
+		if !seen[n.Namespace] {
+			seen[n.Namespace] = true
+			names = append(names, n.Namespace)
+		}
+	}
+	sort.Strings(names)
+
+	var diags diag.Diagnostics
+	config.Namespaces, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: datasource_kubernetes.KubernetesNamespaceAttrTypes()}, namespaces)
+	resp.Diagnostics.Append(diags...)
+	config.Names, diags = types.ListValueFrom(ctx, types.StringType, names)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+type kubernetesNamespacesAPIResponse struct {
+	Data []struct {
+		Namespace        string   `json:"namespace"`
+		Cluster          string   `json:"cluster"`
+		Teams            []string `json:"teams"`
+		Envs             []string `json:"envs"`
+		Pods             *int64   `json:"pods"`
+		CpuUsageMilli    *float64 `json:"cpuUsageMilli"`
+		MemoryUsageBytes *float64 `json:"memoryUsageBytes"`
+	} `json:"data"`
+}
@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccKubernetesNamespacesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "tsuga_kubernetes_namespaces" "all" {}
+
+data "tsuga_kubernetes_namespaces" "none" {
+  kubernetes_cluster = "no-such-cluster"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.tsuga_kubernetes_namespaces.all", "namespaces.#"),
+					resource.TestCheckResourceAttrSet("data.tsuga_kubernetes_namespaces.all", "names.#"),
+					resource.TestCheckResourceAttr("data.tsuga_kubernetes_namespaces.none", "namespaces.#", "0"),
+				),
+			},
+		},
+	})
+}
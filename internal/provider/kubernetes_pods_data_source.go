@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"terraform-provider-tsuga/internal/datasource_kubernetes"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*kubernetesPodsDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*kubernetesPodsDataSource)(nil)
+
+func NewKubernetesPodsDataSource() datasource.DataSource {
+	return &kubernetesPodsDataSource{}
+}
+
+type kubernetesPodsDataSource struct {
+	client *TsugaClient
+}
+
+func (d *kubernetesPodsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*TsugaClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *TsugaClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *kubernetesPodsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kubernetes_pods"
+}
+
+func (d *kubernetesPodsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_kubernetes.KubernetesPodsDataSourceSchema(ctx)
+}
+
+func (d *kubernetesPodsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config datasource_kubernetes.KubernetesPodsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiResp kubernetesPodsAPIResponse
+	resp.Diagnostics.Append(d.client.fetchJSON(ctx, http.MethodGet, kubernetesExplorerPath("pods", config.ClusterId, config.Search), nil, "list Kubernetes pods", &apiResp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pods := []datasource_kubernetes.KubernetesPodModel{}
+	for _, p := range apiResp.Data {
+		if !kubernetesResourceMatches(p.Cluster, p.Namespace, config.KubernetesCluster, config.Namespace) {
+			continue
+		}
+		workloadKind, workloadName := types.StringNull(), types.StringNull()
+		if p.Workload != nil {
+			workloadKind, workloadName = types.StringValue(p.Workload.Kind), types.StringValue(p.Workload.Name)
+		}
+		pods = append(pods, datasource_kubernetes.KubernetesPodModel{
+			Name:              types.StringValue(p.PodName),
+			Namespace:         stringValueOrNull(p.Namespace),
+			KubernetesCluster: stringValueOrNull(p.Cluster),
+			Team:              stringValueOrNull(p.Team),
+			Env:               stringValueOrNull(p.Env),
+			Node:              stringValueOrNull(p.Node),
+			Phase:             types.StringValue(p.Phase),
+			ReadyContainers:   types.Int64Value(p.ReadyContainers),
+			TotalContainers:   types.Int64Value(p.TotalContainers),
+			Restarts:          types.Int64Value(p.Restarts),
+			CreatedAt:         stringValueOrNull(p.CreatedAt),
+			WorkloadKind:      workloadKind,
+			WorkloadName:      workloadName,
+			CpuUsageMilli:     types.Float64PointerValue(p.CpuUsageMilli),
+			CpuLimitMilli:     types.Float64PointerValue(p.CpuLimitMilli),
+			CpuUtilization:    types.Float64PointerValue(p.CpuUtilization),
+			MemoryUsageBytes:  types.Float64PointerValue(p.MemoryUsageBytes),
+			MemoryLimitBytes:  types.Float64PointerValue(p.MemoryLimitBytes),
+			MemoryUtilization: types.Float64PointerValue(p.MemoryUtilization),
+		})
+	}
+
+	podList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: datasource_kubernetes.KubernetesPodAttrTypes()}, pods)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.Pods = podList
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+type kubernetesPodsAPIResponse struct {
+	Data []struct {
+		PodName         string `json:"podName"`
+		Namespace       string `json:"namespace"`
+		Cluster         string `json:"cluster"`
+		Team            string `json:"team"`
+		Env             string `json:"env"`
+		Node            string `json:"node"`
+		Phase           string `json:"phase"`
+		ReadyContainers int64  `json:"readyContainers"`
+		TotalContainers int64  `json:"totalContainers"`
+		Restarts        int64  `json:"restarts"`
+		CreatedAt       string `json:"createdAt"`
+		Workload        *struct {
+			Kind string `json:"kind"`
+			Name string `json:"name"`
+		} `json:"workload"`
+		CpuUsageMilli     *float64 `json:"cpuUsageMilli"`
+		CpuLimitMilli     *float64 `json:"cpuLimitMilli"`
+		MemoryUsageBytes  *float64 `json:"memoryUsageBytes"`
+		MemoryLimitBytes  *float64 `json:"memoryLimitBytes"`
+		CpuUtilization    *float64 `json:"cpuUtilization"`
+		MemoryUtilization *float64 `json:"memoryUtilization"`
+	} `json:"data"`
+}
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"terraform-provider-tsuga/internal/datasource_kubernetes"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*kubernetesWorkloadsDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*kubernetesWorkloadsDataSource)(nil)
+
+func NewKubernetesWorkloadsDataSource() datasource.DataSource {
+	return &kubernetesWorkloadsDataSource{}
+}
+
+// kubernetesWorkloadsDataSource lists the deployments, StatefulSets and DaemonSets
+// of the Kubernetes explorer, which has an endpoint for each kind of workload.
+type kubernetesWorkloadsDataSource struct {
+	client *TsugaClient
+}
+
+func (d *kubernetesWorkloadsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*TsugaClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *TsugaClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *kubernetesWorkloadsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kubernetes_workloads"
+}
+
+func (d *kubernetesWorkloadsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_kubernetes.KubernetesWorkloadsDataSourceSchema(ctx)
+}
+
+func (d *kubernetesWorkloadsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config datasource_kubernetes.KubernetesWorkloadsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kinds, diags := expandStringList(ctx, config.Kinds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if kinds == nil {
+		kinds = datasource_kubernetes.WorkloadKinds
+	}
+
+	workloads := []datasource_kubernetes.KubernetesWorkloadModel{}
+	for _, kind := range kinds {
+		var apiResp kubernetesWorkloadsAPIResponse
+		resp.Diagnostics.Append(d.client.fetchJSON(ctx, http.MethodGet, kubernetesExplorerPath(kind+"s", config.ClusterId, config.Search), nil, "list Kubernetes "+kind+"s", &apiResp)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for _, w := range apiResp.Data {
+			if !kubernetesResourceMatches(w.Cluster, w.Namespace, config.KubernetesCluster, config.Namespace) {
+				continue
+			}
+			workloads = append(workloads, flattenKubernetesWorkload(kind, w))
+		}
+	}
+
+	config.Workloads, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: datasource_kubernetes.KubernetesWorkloadAttrTypes()}, workloads)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// flattenKubernetesWorkload converts a workload of the given kind, whose name and
+// replica fields are named after the kind by the API.
+func flattenKubernetesWorkload(kind string, w kubernetesWorkloadAPIData) datasource_kubernetes.KubernetesWorkloadModel {
+	name, ready, desired := w.Deployment, w.AvailableReplicas, w.DesiredReplicas
+	switch kind {
+	case "statefulset":
+		name, ready = w.StatefulSet, w.ReadyReplicas
+	case "daemonset":
+		name, ready, desired = w.DaemonSet, w.ReadyNodes, w.DesiredNodes
+	}
+
+	return datasource_kubernetes.KubernetesWorkloadModel{
+		Kind:              types.StringValue(kind),
+		Name:              types.StringValue(name),
+		Namespace:         stringValueOrNull(w.Namespace),
+		KubernetesCluster: stringValueOrNull(w.Cluster),
+		Team:              stringValueOrNull(w.Team),
+		Env:               stringValueOrNull(w.Env),
+		Pods:              types.Int64PointerValue(w.Pods),
+		ReadyReplicas:     types.Int64PointerValue(ready),
+		DesiredReplicas:   types.Int64PointerValue(desired),
+		CpuUsageMilli:     types.Float64PointerValue(w.CpuUsageMilli),
+		MemoryUsageBytes:  types.Float64PointerValue(w.MemoryUsageBytes),
+	}
+}
+
+// kubernetesWorkloadAPIData holds the fields of deployments, StatefulSets and
+// DaemonSets. Only the name field of the kind read is set.
+type kubernetesWorkloadAPIData struct {
+	Deployment        string   `json:"deployment"`
+	StatefulSet       string   `json:"statefulSet"`
+	DaemonSet         string   `json:"daemonSet"`
+	Namespace         string   `json:"namespace"`
+	Cluster           string   `json:"cluster"`
+	Team              string   `json:"team"`
+	Env               string   `json:"env"`
+	Pods              *int64   `json:"pods"`
+	AvailableReplicas *int64   `json:"availableReplicas"`
+	ReadyReplicas     *int64   `json:"readyReplicas"`
+	DesiredReplicas   *int64   `json:"desiredReplicas"`
+	ReadyNodes        *int64   `json:"readyNodes"`
+	DesiredNodes      *int64   `json:"desiredNodes"`
+	CpuUsageMilli     *float64 `json:"cpuUsageMilli"`
+	MemoryUsageBytes  *float64 `json:"memoryUsageBytes"`
+}
+
+type kubernetesWorkloadsAPIResponse struct {
+	Data []kubernetesWorkloadAPIData `json:"data"`
+}
@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccKubernetesWorkloadsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "tsuga_kubernetes_workloads" "all" {}
+
+data "tsuga_kubernetes_workloads" "deployments" {
+  kinds = ["deployment"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.tsuga_kubernetes_workloads.all", "workloads.#"),
+					resource.TestCheckResourceAttrSet("data.tsuga_kubernetes_workloads.deployments", "workloads.#"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestKubernetesExplorerPath(t *testing.T) {
+	cases := []struct {
+		clusterID types.String
+		search    types.String
+		want      string
+	}{
+		{types.StringNull(), types.StringNull(), "/v1/kubernetes-explorer/pods"},
+		{types.StringValue("c2"), types.StringValue("api"), "/v1/kubernetes-explorer/pods?clusterId=c2&search=api"},
+	}
+
+	for _, tc := range cases {
+		if got := kubernetesExplorerPath("pods", tc.clusterID, tc.search); got != tc.want {
+			t.Errorf("kubernetesExplorerPath(%s, %s) = %q, want %q", tc.clusterID, tc.search, got, tc.want)
+		}
+	}
+}
+
+func TestFlattenKubernetesWorkload(t *testing.T) {
+	cases := []struct {
+		kind    string
+		body    string
+		ready   int64
+		desired int64
+	}{
+		{"deployment", `{"deployment":"api","availableReplicas":2,"desiredReplicas":3}`, 2, 3},
+		{"statefulset", `{"statefulSet":"api","readyReplicas":1,"desiredReplicas":3}`, 1, 3},
+		{"daemonset", `{"daemonSet":"api","readyNodes":4,"desiredNodes":5}`, 4, 5},
+	}
+
+	for _, tc := range cases {
+		var w kubernetesWorkloadAPIData
+		if err := json.Unmarshal([]byte(tc.body), &w); err != nil {
+			t.Fatal(err)
+		}
+
+		got := flattenKubernetesWorkload(tc.kind, w)
+		if got.Name.ValueString() != "api" || got.ReadyReplicas.ValueInt64() != tc.ready || got.DesiredReplicas.ValueInt64() != tc.desired || !got.Pods.IsNull() {
+			t.Errorf("flattenKubernetesWorkload(%s) = %+v, want api with %d of %d replicas", tc.kind, got, tc.ready, tc.desired)
+		}
+	}
+}
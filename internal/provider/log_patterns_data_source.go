@@ -0,0 +1,341 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"slices"
+	"sort"
+	"strconv"
+	"time"
+
+	"terraform-provider-tsuga/internal/datasource_log_patterns"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*logPatternsDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*logPatternsDataSource)(nil)
+var _ datasource.DataSourceWithValidateConfig = (*logPatternsDataSource)(nil)
+
+func NewLogPatternsDataSource() datasource.DataSource {
+	return &logPatternsDataSource{}
+}
+
+type logPatternsDataSource struct {
+	client *TsugaClient
+}
+
+func (d *logPatternsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*TsugaClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *TsugaClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *logPatternsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_log_patterns"
+}
+
+func (d *logPatternsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_log_patterns.LogPatternsDataSourceSchema(ctx)
+}
+
+// logPatternsAttributes lists, for each type of patterns, the filters it requires
+// and those it supports.
+var logPatternsAttributes = map[string]struct {
+	required  []string
+	supported []string
+}{
+	"all":      {required: []string{"query"}, supported: []string{"query"}},
+	"new":      {supported: []string{"team", "env", "service"}},
+	"increase": {required: []string{"team"}, supported: []string{"team", "env"}},
+}
+
+func (d *logPatternsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config datasource_log_patterns.LogPatternsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateQuerySyntax(config.Query, "query")...)
+
+	if config.Type.IsUnknown() {
+		return
+	}
+	patternType := logPatternsType(config)
+	filters := map[string]types.String{
+		"query":   config.Query,
+		"team":    config.Team,
+		"env":     config.Env,
+		"service": config.Service,
+	}
+	attrs := logPatternsAttributes[patternType]
+	for _, name := range attrs.required {
+		if filters[name].IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Missing required attribute",
+				fmt.Sprintf("%s is required when type is '%s'", name, patternType),
+			)
+		}
+	}
+	for _, name := range []string{"query", "team", "env", "service"} {
+		if !filters[name].IsNull() && !filters[name].IsUnknown() && !slices.Contains(attrs.supported, name) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Unsupported attribute",
+				fmt.Sprintf("%s is not supported when type is '%s'", name, patternType),
+			)
+		}
+	}
+}
+
+func (d *logPatternsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config datasource_log_patterns.LogPatternsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeRange, diags := expandQueryTimeRange(config.Window, config.To)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := url.Values{}
+	query.Set("from", strconv.FormatInt(timeRange.From, 10))
+	query.Set("to", strconv.FormatInt(timeRange.To, 10))
+	for name, value := range map[string]types.String{
+		"clusterId": config.ClusterId,
+		"query":     config.Query,
+		"team":      config.Team,
+		"env":       config.Env,
+		"service":   config.Service,
+	} {
+		if !value.IsNull() {
+			query.Set(name, value.ValueString())
+		}
+	}
+
+	var patterns []datasource_log_patterns.PatternModel
+	switch logPatternsType(config) {
+	case "new":
+		var apiResp newErrorPatternsAPIResponse
+		resp.Diagnostics.Append(d.client.fetchJSON(ctx, http.MethodGet, "/v1/logs/patterns/new?"+query.Encode(), nil, "list new error patterns", &apiResp)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for _, p := range apiResp.Data.NewErrorPatterns {
+			patterns = append(patterns, flattenNewErrorPattern(p))
+		}
+	case "increase":
+		var apiResp errorPatternIncreasesAPIResponse
+		resp.Diagnostics.Append(d.client.fetchJSON(ctx, http.MethodGet, "/v1/logs/patterns/increase?"+query.Encode(), nil, "list error pattern increases", &apiResp)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for _, p := range apiResp.Data.ErrorPatternIncreases {
+			pattern, diags := flattenErrorPatternIncrease(ctx, p)
+			resp.Diagnostics.Append(diags...)
+			patterns = append(patterns, pattern)
+		}
+	default:
+		var apiResp logPatternsAPIResponse
+		resp.Diagnostics.Append(d.client.fetchJSON(ctx, http.MethodGet, "/v1/logs/patterns?"+query.Encode(), nil, "list log patterns", &apiResp)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for _, p := range apiResp.Data.Patterns {
+			pattern, diags := flattenLogPattern(ctx, p)
+			resp.Diagnostics.Append(diags...)
+			patterns = append(patterns, pattern)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	services := []string{}
+	seen := map[string]bool{}
+	for _, p := range patterns {
+		if s := p.Service.ValueString(); s != "" && !seen[s] {
+			seen[s] = true
+			services = append(services, s)
+		}
+	}
+	sort.Strings(services)
+
+	if patterns == nil {
+		patterns = []datasource_log_patterns.PatternModel{}
+	}
+	config.Patterns, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: datasource_log_patterns.PatternAttrTypes()}, patterns)
+	resp.Diagnostics.Append(diags...)
+	config.Services, diags = types.ListValueFrom(ctx, types.StringType, services)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func logPatternsType(config datasource_log_patterns.LogPatternsModel) string {
+	if config.Type.IsNull() {
+		return "all"
+	}
+	return config.Type.ValueString()
+}
+
+// logPatternGroupKeys are the attribute paths a pattern's service, team and
+// environment can be grouped under.
+var logPatternGroupKeys = map[string][]string{
+	"service": {"context.service", "context.service.name", "service.name", "service"},
+	"team":    {"context.team", "team"},
+	"env":     {"context.env", "context.environment", "env"},
+}
+
+func flattenLogPattern(ctx context.Context, p logPatternAPIData) (datasource_log_patterns.PatternModel, diag.Diagnostics) {
+	groups := make(map[string]string, len(p.Groups))
+	for _, g := range p.Groups {
+		groups[g.Key] = g.Value
+	}
+	groupValue := func(field string) types.String {
+		for _, key := range logPatternGroupKeys[field] {
+			if v, ok := groups[key]; ok {
+				return types.StringValue(v)
+			}
+		}
+		return types.StringNull()
+	}
+
+	groupsValue, diags := types.MapValueFrom(ctx, types.StringType, groups)
+	return datasource_log_patterns.PatternModel{
+		Pattern:     types.StringValue(p.Pattern),
+		Count:       types.Int64Value(p.Size),
+		Service:     groupValue("service"),
+		Team:        groupValue("team"),
+		Env:         groupValue("env"),
+		Groups:      groupsValue,
+		FirstSeenAt: types.StringNull(),
+		LastSeenAt:  types.StringNull(),
+		IncreasedAt: types.ListNull(types.StringType),
+	}, diags
+}
+
+func flattenNewErrorPattern(p newErrorPatternAPIData) datasource_log_patterns.PatternModel {
+	return datasource_log_patterns.PatternModel{
+		Pattern:     logMessageValue(p.ExampleLog.Message),
+		Count:       types.Int64Null(),
+		Service:     stringValueOrNull(p.Service),
+		Team:        stringValueOrNull(p.Team),
+		Env:         stringValueOrNull(p.Env),
+		Groups:      types.MapNull(types.StringType),
+		FirstSeenAt: types.StringValue(unixTimestamp(p.FirstSeen, time.Second)),
+		LastSeenAt:  types.StringValue(unixTimestamp(p.LastSeen, time.Second)),
+		IncreasedAt: types.ListNull(types.StringType),
+	}
+}
+
+func flattenErrorPatternIncrease(ctx context.Context, p errorPatternIncreaseAPIData) (datasource_log_patterns.PatternModel, diag.Diagnostics) {
+	increases := make([]string, 0, len(p.IncreaseTimestamps))
+	for _, ts := range p.IncreaseTimestamps {
+		increases = append(increases, unixTimestamp(ts, time.Millisecond))
+	}
+
+	increasedAt, diags := types.ListValueFrom(ctx, types.StringType, increases)
+	return datasource_log_patterns.PatternModel{
+		Pattern:     types.StringValue(p.Pattern),
+		Count:       types.Int64Value(int64(len(p.IncreaseTimestamps))),
+		Service:     types.StringNull(),
+		Team:        stringValueOrNull(p.Team),
+		Env:         stringValueOrNull(p.Env),
+		Groups:      types.MapNull(types.StringType),
+		FirstSeenAt: types.StringNull(),
+		LastSeenAt:  types.StringNull(),
+		IncreasedAt: increasedAt,
+	}, diags
+}
+
+// logMessageValue formats the message of a log, which can be any JSON value once
+// processed by routes, as a string.
+func logMessageValue(message json.RawMessage) types.String {
+	if len(message) == 0 || string(message) == "null" {
+		return types.StringNull()
+	}
+	var s string
+	if err := json.Unmarshal(message, &s); err == nil {
+		return types.StringValue(s)
+	}
+	return types.StringValue(string(message))
+}
+
+// unixTimestamp formats a Unix timestamp counted in unit as an RFC 3339 timestamp.
+func unixTimestamp(value float64, unit time.Duration) string {
+	return time.Unix(0, int64(math.Round(value*float64(unit)))).UTC().Format(time.RFC3339)
+}
+
+type logPatternAPIData struct {
+	Pattern string `json:"pattern"`
+	Size    int64  `json:"size"`
+	Groups  []struct {
+		Key   string `json:"key"`
+		Value string `json:"value"`
+	} `json:"groups"`
+}
+
+type logPatternsAPIResponse struct {
+	Data struct {
+		Patterns []logPatternAPIData `json:"patterns"`
+	} `json:"data"`
+}
+
+type newErrorPatternAPIData struct {
+	ExampleLog struct {
+		Message json.RawMessage `json:"message"`
+	} `json:"exampleLog"`
+	Team      string  `json:"team"`
+	Env       string  `json:"env"`
+	Service   string  `json:"service"`
+	FirstSeen float64 `json:"firstSeen"`
+	LastSeen  float64 `json:"lastSeen"`
+}
+
+type newErrorPatternsAPIResponse struct {
+	Data struct {
+		NewErrorPatterns []newErrorPatternAPIData `json:"newErrorPatterns"`
+	} `json:"data"`
+}
+
+type errorPatternIncreaseAPIData struct {
+	Team               string    `json:"team"`
+	Env                string    `json:"env"`
+	Pattern            string    `json:"pattern"`
+	IncreaseTimestamps []float64 `json:"increaseTimestamps"`
+}
+
+type errorPatternIncreasesAPIResponse struct {
+	Data struct {
+		ErrorPatternIncreases []errorPatternIncreaseAPIData `json:"errorPatternIncreases"`
+	} `json:"data"`
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLogPatternsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "tsuga_log_patterns" "errors" {
+  window = "1h"
+  query  = "level:ERROR"
+}
+
+data "tsuga_log_patterns" "new" {
+  type   = "new"
+  window = "7d"
+  to     = "2024-06-01T00:00:00Z"
+  env    = "production"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.tsuga_log_patterns.errors", "patterns.#"),
+					resource.TestCheckResourceAttrSet("data.tsuga_log_patterns.new", "patterns.#"),
+					resource.TestCheckResourceAttrSet("data.tsuga_log_patterns.new", "services.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLogPatternsDataSource_InvalidFilters(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "tsuga_log_patterns" "test" {
+  type    = "increase"
+  window  = "1d"
+  service = "api"
+}
+`,
+				ExpectError: regexp.MustCompile(`team is required when type is 'increase'`),
+			},
+		},
+	})
+}
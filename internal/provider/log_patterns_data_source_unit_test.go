@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFlattenLogPattern(t *testing.T) {
+	var p logPatternAPIData
+	if err := json.Unmarshal([]byte(`{"pattern":"timeout after <num>ms","size":12,"groups":[{"key":"level","value":"ERROR"},{"key":"context.service","value":"api"}]}`), &p); err != nil {
+		t.Fatal(err)
+	}
+
+	got, diags := flattenLogPattern(context.Background(), p)
+	if diags.HasError() {
+		t.Fatalf("flattenLogPattern() diagnostics = %v", diags)
+	}
+	if got.Count.ValueInt64() != 12 || got.Service.ValueString() != "api" || !got.Team.IsNull() || len(got.Groups.Elements()) != 2 {
+		t.Errorf("flattenLogPattern() = %+v, want 12 logs of service api in 2 groups", got)
+	}
+}
+
+func TestFlattenNewErrorPattern(t *testing.T) {
+	cases := []struct {
+		message string
+		want    types.String
+	}{
+		{`"connection refused"`, types.StringValue("connection refused")},
+		{`{"error":"connection refused"}`, types.StringValue(`{"error":"connection refused"}`)},
+		{`null`, types.StringNull()},
+	}
+
+	for _, tc := range cases {
+		var p newErrorPatternAPIData
+		body := `{"team":"platform","env":"production","service":"api","firstSeen":1717200000,"lastSeen":1717203600,"exampleLog":{"timestamp":1717203600000,"level":"ERROR","message":` + tc.message + `}}`
+		if err := json.Unmarshal([]byte(body), &p); err != nil {
+			t.Fatal(err)
+		}
+
+		got := flattenNewErrorPattern(p)
+		if !got.Pattern.Equal(tc.want) {
+			t.Errorf("flattenNewErrorPattern() pattern of message %s = %s, want %s", tc.message, got.Pattern, tc.want)
+		}
+		if got.FirstSeenAt.ValueString() != "2024-06-01T00:00:00Z" || got.LastSeenAt.ValueString() != "2024-06-01T01:00:00Z" || got.Service.ValueString() != "api" {
+			t.Errorf("flattenNewErrorPattern() = %+v", got)
+		}
+	}
+}
+
+func TestFlattenErrorPatternIncrease(t *testing.T) {
+	p := errorPatternIncreaseAPIData{Team: "platform", Pattern: "timeout", IncreaseTimestamps: []float64{1717200000000, 1717203600000}}
+
+	got, diags := flattenErrorPatternIncrease(context.Background(), p)
+	if diags.HasError() {
+		t.Fatalf("flattenErrorPatternIncrease() diagnostics = %v", diags)
+	}
+	want, _ := types.ListValueFrom(context.Background(), types.StringType, []string{"2024-06-01T00:00:00Z", "2024-06-01T01:00:00Z"})
+	if got.Count.ValueInt64() != 2 || !got.IncreasedAt.Equal(want) || !got.Env.IsNull() {
+		t.Errorf("flattenErrorPatternIncrease() = %+v, want 2 increases", got)
+	}
+}
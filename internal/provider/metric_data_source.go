@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"terraform-provider-tsuga/internal/datasource_metrics"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+var _ datasource.DataSource = (*metricDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*metricDataSource)(nil)
+
+func NewMetricDataSource() datasource.DataSource {
+	return &metricDataSource{}
+}
+
+type metricDataSource struct {
+	client *TsugaClient
+}
+
+func (d *metricDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*TsugaClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *TsugaClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *metricDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metric"
+}
+
+func (d *metricDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_metrics.MetricDataSourceSchema(ctx)
+}
+
+func (d *metricDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config datasource_metrics.MetricModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query, diags := metricsWindowQuery(config.Window, config.ClusterId)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiResp metricAPIResponse
+	metricPath := fmt.Sprintf("/v1/metrics/%s?%s", url.PathEscape(config.Name.ValueString()), query.Encode())
+	resp.Diagnostics.Append(d.client.fetchJSON(ctx, http.MethodGet, metricPath, nil, "read metric", &apiResp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	item, diags := flattenMetric(ctx, apiResp.Data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config.MetricItemModel = item
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+type metricAPIResponse struct {
+	Data metricAPIData `json:"data"`
+}
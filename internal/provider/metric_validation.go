@@ -0,0 +1,295 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"terraform-provider-tsuga/internal/aggregate"
+	"terraform-provider-tsuga/internal/resource_dashboard"
+	"terraform-provider-tsuga/internal/resource_monitor"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// metricValidationWindow is how far back the metrics checked by the provider's
+// `validate_metrics` must have been reported.
+const metricValidationWindow = 7 * 24 * time.Hour
+
+// counterFunctions are the query functions computing the change of a cumulative
+// value, which a gauge does not have.
+var counterFunctions = []string{"per-second", "per-minute", "per-hour", "rate", "increase"}
+
+// metricCatalog holds the metrics of the client's cluster, listed once for all the
+// resources a plan checks.
+type metricCatalog struct {
+	once    sync.Once
+	metrics map[string]metricAPIData
+	err     error
+}
+
+func (c *TsugaClient) listMetricCatalog(ctx context.Context) (map[string]metricAPIData, error) {
+	c.metricCatalog.once.Do(func() {
+		to := time.Now()
+		metricsPath := fmt.Sprintf("/v1/metrics?from=%s&to=%s",
+			strconv.FormatInt(to.Add(-metricValidationWindow).Unix(), 10), strconv.FormatInt(to.Unix(), 10))
+
+		var apiResp metricsListAPIResponse
+		diags := c.fetchJSON(ctx, http.MethodGet, metricsPath, nil, "list metrics", &apiResp)
+		if diags.HasError() {
+			c.metricCatalog.err = fmt.Errorf("%s", diags.Errors()[0].Detail())
+			return
+		}
+		c.metricCatalog.metrics = make(map[string]metricAPIData, len(apiResp.Data))
+		for _, m := range apiResp.Data {
+			c.metricCatalog.metrics[m.Name] = m
+		}
+	})
+	return c.metricCatalog.metrics, c.metricCatalog.err
+}
+
+// metricQuery is a metrics query of a monitor or dashboard plan, as checked by
+// checkMetricQuery.
+type metricQuery struct {
+	// path is the query's attribute path, such as `configuration.metric.queries[0]`.
+	path string
+	// metric is the `field` of the query's aggregate.
+	metric types.String
+	// functions are the API names of the query's functions, such as `per-second`.
+	functions []string
+}
+
+// warnMetricQueries warns about the metrics queries of a plan that reference a
+// metric the API has not reported, or apply a function the metric's instrument
+// type does not support, when the provider's `validate_metrics` is set. The API
+// accepts both, and the monitor or graph then silently has no data. The queries
+// are those collect finds in the plan.
+func warnMetricQueries(ctx context.Context, client *TsugaClient, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, collect func(context.Context, resource.ModifyPlanRequest) []metricQuery) {
+	if client == nil || !client.ValidateMetrics || req.Plan.Raw.IsNull() {
+		return
+	}
+	queries := collect(ctx, req)
+	if len(queries) == 0 {
+		return
+	}
+
+	catalog, err := client.listMetricCatalog(ctx)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Metric Validation Skipped",
+			fmt.Sprintf("The metrics referenced by this resource were not checked, as listing the metrics of the cluster failed: %s", err),
+		)
+		return
+	}
+
+	for _, q := range queries {
+		resp.Diagnostics.Append(checkMetricQuery(catalog, q)...)
+	}
+}
+
+// checkMetricQuery returns the warnings of warnMetricQueries for one query.
+// Queries whose metric is not known yet are skipped.
+func checkMetricQuery(catalog map[string]metricAPIData, q metricQuery) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if q.metric.IsNull() || q.metric.IsUnknown() {
+		return diags
+	}
+
+	name := q.metric.ValueString()
+	metric, ok := catalog[name]
+	if !ok {
+		diags.AddAttributeWarning(
+			attributePath(q.path+".aggregate"),
+			"Unknown Metric",
+			fmt.Sprintf("%s: metric %q was not reported in the last %d days, so the query will return no data. Check its name with the tsuga_metrics data source.", q.path, name, int(metricValidationWindow.Hours()/24)),
+		)
+		return diags
+	}
+
+	if metric.Type != "gauge" {
+		return diags
+	}
+	for i, fn := range q.functions {
+		if containsFold(counterFunctions, fn) {
+			diags.AddAttributeWarning(
+				attributePath(fmt.Sprintf("%s.functions[%d]", q.path, i)),
+				"Incompatible Metric Function",
+				fmt.Sprintf("%s.functions[%d]: %s computes the change of a counter, but %q is a gauge, whose values are not cumulative.", q.path, i, fn, name),
+			)
+		}
+	}
+	return diags
+}
+
+// monitorMetricQueries returns the queries of the metric and anomaly_metric
+// configurations of a monitor plan.
+func monitorMetricQueries(ctx context.Context, req resource.ModifyPlanRequest) []metricQuery {
+	var out []metricQuery
+	for _, kind := range []string{"metric", "anomaly_metric"} {
+		var queries types.List
+		diags := req.Plan.GetAttribute(ctx, path.Root("configuration").AtName(kind).AtName("queries"), &queries)
+		if diags.HasError() || queries.IsNull() || queries.IsUnknown() {
+			continue
+		}
+		var models []resource_monitor.MonitorQueryModel
+		if diags := queries.ElementsAs(ctx, &models, false); diags.HasError() {
+			continue
+		}
+		for i, q := range models {
+			mq := metricQuery{
+				path:   fmt.Sprintf("configuration.%s.queries[%d]", kind, i),
+				metric: monitorAggregateField(q.Aggregate),
+			}
+			var functions []resource_monitor.AggregationFunctionModel
+			if !q.Functions.IsNull() && !q.Functions.IsUnknown() && !q.Functions.ElementsAs(ctx, &functions, false).HasError() {
+				for _, fn := range functions {
+					mq.functions = append(mq.functions, monitorFunctionName(fn))
+				}
+			}
+			out = append(out, mq)
+		}
+	}
+	return out
+}
+
+func monitorAggregateField(agg resource_monitor.MonitorAggregateModel) types.String {
+	var count *aggregate.FieldModel
+	if agg.Count != nil {
+		count = &aggregate.FieldModel{Field: agg.Count.Field}
+	}
+	var percentile *aggregate.FieldModel
+	if agg.Percentile != nil {
+		percentile = &aggregate.FieldModel{Field: agg.Percentile.Field}
+	}
+	return firstAggregateField(count, agg.Average, agg.Max, agg.Min, agg.Sum, percentile, agg.UniqueCount)
+}
+
+// monitorFunctionName returns the API name of a monitor query function, such as
+// `per-second` for per_second.
+func monitorFunctionName(fn resource_monitor.AggregationFunctionModel) string {
+	switch {
+	case fn.PerSecond != nil:
+		return "per-second"
+	case fn.PerMinute != nil:
+		return "per-minute"
+	case fn.PerHour != nil:
+		return "per-hour"
+	case fn.Rate != nil:
+		return "rate"
+	case fn.Increase != nil:
+		return "increase"
+	case fn.Last != nil:
+		return "last"
+	case fn.Rolling != nil:
+		return "rolling"
+	case fn.TimeOffset != nil:
+		return "time-offset"
+	}
+	return ""
+}
+
+// dashboardMetricQueries returns the queries of the graphs and table columns of a
+// dashboard plan whose source is `metrics`.
+func dashboardMetricQueries(ctx context.Context, req resource.ModifyPlanRequest) []metricQuery {
+	var graphs types.List
+	diags := req.Plan.GetAttribute(ctx, path.Root("graphs"), &graphs)
+	if diags.HasError() || graphs.IsNull() || graphs.IsUnknown() {
+		return nil
+	}
+	var models []resource_dashboard.GraphModel
+	if diags := graphs.ElementsAs(ctx, &models, false); diags.HasError() {
+		return nil
+	}
+
+	var out []metricQuery
+	for i, graph := range models {
+		vis := graph.Visualization
+		prefix := fmt.Sprintf("graphs[%d].visualization", i)
+		addSeries := func(name string, base *resource_dashboard.SeriesBase) {
+			out = append(out, dashboardSourceQueries(ctx, base.Source, base.Queries, fmt.Sprintf("%s.%s", prefix, name))...)
+		}
+		if vis.Timeseries != nil {
+			addSeries("timeseries", &vis.Timeseries.SeriesBase)
+		}
+		if vis.TopList != nil {
+			addSeries("top_list", &vis.TopList.SeriesBase)
+		}
+		if vis.Pie != nil {
+			addSeries("pie", &vis.Pie.SeriesBase)
+		}
+		if vis.QueryValue != nil {
+			addSeries("query_value", &vis.QueryValue.SeriesBase)
+		}
+		if vis.Bar != nil {
+			addSeries("bar", &vis.Bar.SeriesBase)
+		}
+		if vis.Gauge != nil {
+			addSeries("gauge", &vis.Gauge.SeriesBase)
+		}
+		if vis.Distribution != nil {
+			addSeries("distribution", &vis.Distribution.SeriesBase)
+		}
+		if vis.Heatmap != nil {
+			addSeries("heatmap", &vis.Heatmap.SeriesBase)
+		}
+
+		if vis.Table != nil && !vis.Table.Columns.IsNull() && !vis.Table.Columns.IsUnknown() {
+			var columns []resource_dashboard.TableColumnModel
+			if !vis.Table.Columns.ElementsAs(ctx, &columns, false).HasError() {
+				for j, col := range columns {
+					out = append(out, dashboardSourceQueries(ctx, col.Source, col.Queries, fmt.Sprintf("%s.table.columns[%d]", prefix, j))...)
+				}
+			}
+		}
+	}
+	return out
+}
+
+func dashboardSourceQueries(ctx context.Context, source types.String, queries types.List, pathPrefix string) []metricQuery {
+	if source.ValueString() != "metrics" || queries.IsNull() || queries.IsUnknown() {
+		return nil
+	}
+	var models []resource_dashboard.QueryModel
+	if diags := queries.ElementsAs(ctx, &models, false); diags.HasError() {
+		return nil
+	}
+
+	var out []metricQuery
+	for i, q := range models {
+		var count, percentile *aggregate.FieldModel
+		if q.Aggregate.Count != nil {
+			count = &aggregate.FieldModel{Field: q.Aggregate.Count.Field}
+		}
+		if q.Aggregate.Percentile != nil {
+			percentile = &aggregate.FieldModel{Field: q.Aggregate.Percentile.Field}
+		}
+		mq := metricQuery{
+			path:   fmt.Sprintf("%s.queries[%d]", pathPrefix, i),
+			metric: firstAggregateField(count, q.Aggregate.Sum, q.Aggregate.Average, q.Aggregate.Min, q.Aggregate.Max, q.Aggregate.Uniq, percentile),
+		}
+		var functions []resource_dashboard.FunctionModel
+		if !q.Functions.IsNull() && !q.Functions.IsUnknown() && !q.Functions.ElementsAs(ctx, &functions, false).HasError() {
+			for _, fn := range functions {
+				mq.functions = append(mq.functions, fn.Type.ValueString())
+			}
+		}
+		out = append(out, mq)
+	}
+	return out
+}
+
+// firstAggregateField returns the field of the first aggregate set, or null.
+func firstAggregateField(aggregates ...*aggregate.FieldModel) types.String {
+	for _, agg := range aggregates {
+		if agg != nil && !agg.Field.IsNull() {
+			return agg.Field
+		}
+	}
+	return types.StringNull()
+}
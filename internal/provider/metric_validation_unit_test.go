@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCheckMetricQuery(t *testing.T) {
+	catalog := map[string]metricAPIData{
+		"http.server.requests": {Name: "http.server.requests", Type: "counter"},
+		"k8s.node.cpu.usage":   {Name: "k8s.node.cpu.usage", Type: "gauge"},
+	}
+
+	cases := []struct {
+		name    string
+		query   metricQuery
+		summary string
+	}{
+		{"counter with rate", metricQuery{metric: types.StringValue("http.server.requests"), functions: []string{"rate"}}, ""},
+		{"gauge without functions", metricQuery{metric: types.StringValue("k8s.node.cpu.usage")}, ""},
+		{"gauge with rolling", metricQuery{metric: types.StringValue("k8s.node.cpu.usage"), functions: []string{"rolling"}}, ""},
+		{"gauge with per-second", metricQuery{metric: types.StringValue("k8s.node.cpu.usage"), functions: []string{"rolling", "per-second"}}, "Incompatible Metric Function"},
+		{"unknown metric", metricQuery{metric: types.StringValue("k8s.node.cpu.usages")}, "Unknown Metric"},
+		{"metric not known yet", metricQuery{metric: types.StringUnknown()}, ""},
+		{"no field", metricQuery{metric: types.StringNull()}, ""},
+	}
+	for _, tc := range cases {
+		tc.query.path = "configuration.metric.queries[0]"
+		diags := checkMetricQuery(catalog, tc.query)
+		if tc.summary == "" {
+			if len(diags) != 0 {
+				t.Errorf("%s: checkMetricQuery() = %v, want no diagnostics", tc.name, diags)
+			}
+			continue
+		}
+		if len(diags) != 1 || diags[0].Summary() != tc.summary || diags.HasError() {
+			t.Errorf("%s: checkMetricQuery() = %v, want one %q warning", tc.name, diags, tc.summary)
+		}
+	}
+}
+
+func TestListMetricCatalog(t *testing.T) {
+	t.Parallel()
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/v1/metrics" || r.URL.Query().Get("clusterId") != "c1" || r.URL.Query().Get("from") == "" {
+			t.Errorf("unexpected request to %s", r.URL)
+		}
+		_, _ = w.Write([]byte(`{"data":[{"name":"k8s.node.cpu.usage","type":"gauge"}]}`))
+	}))
+	defer server.Close()
+	client := &TsugaClient{BaseURL: server.URL, ClusterID: "c1", client: server.Client()}
+
+	for range 2 {
+		catalog, err := client.listMetricCatalog(context.Background())
+		if err != nil {
+			t.Fatalf("listMetricCatalog() error = %v", err)
+		}
+		if catalog["k8s.node.cpu.usage"].Type != "gauge" {
+			t.Errorf("listMetricCatalog() = %v, want k8s.node.cpu.usage as a gauge", catalog)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("listMetricCatalog() made %d requests, want 1", requests)
+	}
+}
+
+func TestListMetricCatalog_Error(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"error":{"code":"forbidden","message":"no access"}}`))
+	}))
+	defer server.Close()
+	client := &TsugaClient{BaseURL: server.URL, client: server.Client()}
+
+	if _, err := client.listMetricCatalog(context.Background()); err == nil || !strings.Contains(err.Error(), "no access") {
+		t.Fatalf("listMetricCatalog() error = %v, want the API error", err)
+	}
+}
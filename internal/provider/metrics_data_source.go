@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"terraform-provider-tsuga/internal/datasource_metrics"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*metricsDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*metricsDataSource)(nil)
+
+// defaultMetricsWindow is the window of the metrics data sources when `window` is
+// unset.
+const defaultMetricsWindow = 24 * time.Hour
+
+func NewMetricsDataSource() datasource.DataSource {
+	return &metricsDataSource{}
+}
+
+type metricsDataSource struct {
+	client *TsugaClient
+}
+
+func (d *metricsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*TsugaClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *TsugaClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *metricsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metrics"
+}
+
+func (d *metricsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_metrics.MetricsDataSourceSchema(ctx)
+}
+
+func (d *metricsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config datasource_metrics.MetricsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query, diags := metricsWindowQuery(config.Window, config.ClusterId)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var apiResp metricsListAPIResponse
+	resp.Diagnostics.Append(d.client.fetchJSON(ctx, http.MethodGet, "/v1/metrics?"+query.Encode(), nil, "list metrics", &apiResp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items := []datasource_metrics.MetricItemModel{}
+	names := []string{}
+	for _, m := range apiResp.Data {
+		if !config.NamePrefix.IsNull() && !strings.HasPrefix(m.Name, config.NamePrefix.ValueString()) {
+			continue
+		}
+		if !config.Type.IsNull() && m.Type != config.Type.ValueString() {
+			continue
+		}
+		item, diags := flattenMetric(ctx, m)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		items = append(items, item)
+		names = append(names, m.Name)
+	}
+
+	config.Metrics, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: datasource_metrics.MetricAttrTypes()}, items)
+	resp.Diagnostics.Append(diags...)
+	config.Names, diags = types.ListValueFrom(ctx, types.StringType, names)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// metricsWindowQuery returns the from, to and clusterId query parameters of a
+// metrics request covering window before now.
+func metricsWindowQuery(window, clusterID types.String) (url.Values, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	d := defaultMetricsWindow
+	if !window.IsNull() {
+		var err error
+		d, err = parseDuration(window.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("window"), "Invalid Duration", err.Error())
+			return nil, diags
+		}
+	}
+
+	to := time.Now()
+	query := url.Values{}
+	query.Set("from", strconv.FormatInt(to.Add(-d).Unix(), 10))
+	query.Set("to", strconv.FormatInt(to.Unix(), 10))
+	if !clusterID.IsNull() {
+		query.Set("clusterId", clusterID.ValueString())
+	}
+	return query, diags
+}
+
+func flattenMetric(ctx context.Context, m metricAPIData) (datasource_metrics.MetricItemModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	list := func(values []string) types.List {
+		if values == nil {
+			values = []string{}
+		}
+		l, d := types.ListValueFrom(ctx, types.StringType, values)
+		diags.Append(d...)
+		return l
+	}
+
+	return datasource_metrics.MetricItemModel{
+		Name:         types.StringValue(m.Name),
+		Type:         types.StringValue(m.Type),
+		Unit:         stringValueOrNull(m.Unit),
+		Temporality:  stringValueOrNull(m.Temporality),
+		Capabilities: list(m.Capabilities),
+		Attributes:   list(m.Attributes),
+	}, diags
+}
+
+type metricAPIData struct {
+	Name         string   `json:"name"`
+	Type         string   `json:"type"`
+	Unit         string   `json:"unit"`
+	Temporality  string   `json:"temporality"`
+	Capabilities []string `json:"capabilities"`
+	Attributes   []string `json:"attributes"`
+}
+
+type metricsListAPIResponse struct {
+	Data []metricAPIData `json:"data"`
+}
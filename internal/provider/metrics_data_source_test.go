@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMetricsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "tsuga_metrics" "all" {
+  window = "7d"
+}
+
+data "tsuga_metrics" "none" {
+  name_prefix = "no.such.metric."
+}
+
+data "tsuga_metric" "first" {
+  name   = data.tsuga_metrics.all.names[0]
+  window = "7d"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.tsuga_metrics.all", "metrics.0.name"),
+					resource.TestCheckResourceAttrSet("data.tsuga_metrics.all", "metrics.0.type"),
+					resource.TestCheckResourceAttrPair("data.tsuga_metrics.all", "names.0", "data.tsuga_metrics.all", "metrics.0.name"),
+					resource.TestCheckResourceAttr("data.tsuga_metrics.none", "metrics.#", "0"),
+					resource.TestCheckResourceAttrPair("data.tsuga_metric.first", "type", "data.tsuga_metrics.all", "metrics.0.type"),
+				),
+			},
+		},
+	})
+}
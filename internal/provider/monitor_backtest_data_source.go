@@ -0,0 +1,262 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"terraform-provider-tsuga/internal/backtest"
+	"terraform-provider-tsuga/internal/datasource_monitor_backtest"
+	"terraform-provider-tsuga/internal/resource_monitor"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*monitorBacktestDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*monitorBacktestDataSource)(nil)
+var _ datasource.DataSourceWithValidateConfig = (*monitorBacktestDataSource)(nil)
+
+func NewMonitorBacktestDataSource() datasource.DataSource {
+	return &monitorBacktestDataSource{}
+}
+
+// monitorBacktestDataSource fetches the timeseries of a monitor's condition
+// formulas and replays its alerting logic locally with the backtest package.
+type monitorBacktestDataSource struct {
+	client *TsugaClient
+}
+
+func (d *monitorBacktestDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*TsugaClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *TsugaClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *monitorBacktestDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitor_backtest"
+}
+
+func (d *monitorBacktestDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_monitor_backtest.MonitorBacktestDataSourceSchema(ctx)
+}
+
+func (d *monitorBacktestDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config datasource_monitor_backtest.MonitorBacktestModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if details, kind := backtestConfiguration(config.Configuration); details != nil {
+		resp.Diagnostics.Append(validateThresholdMonitorConfig(ctx, details, "configuration."+kind)...)
+	}
+}
+
+func (d *monitorBacktestDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config datasource_monitor_backtest.MonitorBacktestModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	details, kind := backtestConfiguration(config.Configuration)
+	if details == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("configuration"),
+			"Unsupported Monitor Type",
+			"Only metric, log and trace monitor configurations can be backtested.",
+		)
+		return
+	}
+
+	timeRange, diags := expandQueryTimeRange(config.Window, config.To)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	step := time.Duration(details.Timeframe.ValueInt64()) * time.Minute
+	// The window is moved back to end on a multiple of the timeframe, so that its
+	// buckets line up with those of the timeseries.
+	to := time.Unix(timeRange.To, 0).Truncate(step)
+	buckets := time.Duration(timeRange.To-timeRange.From) * time.Second / step
+	if buckets < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("window"),
+			"Invalid Duration",
+			fmt.Sprintf("window must cover at least one timeframe of the monitor, %d minutes.", details.Timeframe.ValueInt64()),
+		)
+		return
+	}
+	from := to.Add(-buckets * step)
+
+	monitor, diags := d.fetchBacktestMonitor(ctx, config.ClusterId, kind, details, queryAPITimeRange{From: from.Unix(), To: to.Unix()})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := backtest.Evaluate(monitor, from, to, step)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("configuration").AtName(kind), "Invalid Monitor Configuration", err.Error())
+		return
+	}
+
+	config.Evaluations = types.Int64Value(int64(result.Evaluations))
+	config.TriggerCount = types.Int64Value(int64(len(result.Triggers)))
+	config.FirstTriggeredAt, config.LastTriggeredAt = triggerTimeBounds(result.Triggers)
+	config.TriggeredAt, diags = types.ListValueFrom(ctx, types.StringType, formatTriggerTimes(result.Triggers))
+	resp.Diagnostics.Append(diags...)
+
+	groups := make([]attr.Value, 0, len(result.Groups))
+	for _, g := range result.Groups {
+		labels, diags := types.MapValueFrom(ctx, types.StringType, g.Group)
+		resp.Diagnostics.Append(diags...)
+		first, last := triggerTimeBounds(g.Triggers)
+		groups = append(groups, types.ObjectValueMust(datasource_monitor_backtest.GroupAttrTypes(), map[string]attr.Value{
+			"group":                 labels,
+			"trigger_count":         types.Int64Value(int64(len(g.Triggers))),
+			"first_triggered_at":    first,
+			"last_triggered_at":     last,
+			"breaching_evaluations": types.Int64Value(int64(g.Breaches)),
+			"no_data_evaluations":   types.Int64Value(int64(g.NoData)),
+		}))
+	}
+	config.Groups, diags = types.ListValue(types.ObjectType{AttrTypes: datasource_monitor_backtest.GroupAttrTypes()}, groups)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// fetchBacktestMonitor reads the timeseries of each distinct condition formula of a
+// threshold monitor, in buckets of its timeframe, and returns the monitor to
+// evaluate.
+func (d *monitorBacktestDataSource) fetchBacktestMonitor(ctx context.Context, clusterID types.String, kind string, details *resource_monitor.MonitorConfigurationDetailsModel, timeRange queryAPITimeRange) (backtest.Monitor, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	queries, qDiags := expandMonitorQueries(ctx, details.Queries)
+	diags.Append(qDiags...)
+	groupBy, gDiags := expandAggregationGroupBy(ctx, details.GroupByFields)
+	diags.Append(gDiags...)
+	var conditions []resource_monitor.MonitorConditionModel
+	diags.Append(details.Conditions.ElementsAs(ctx, &conditions, false)...)
+	if diags.HasError() {
+		return backtest.Monitor{}, diags
+	}
+
+	monitor := backtest.Monitor{
+		Grouped:             len(groupBy) > 0,
+		AlertLogic:          details.AggregationAlertLogic.ValueString(),
+		ProportionThreshold: details.ProportionAlertThreshold.ValueInt64(),
+		NoDataBehavior:      details.NoDataBehavior.ValueString(),
+	}
+	series := map[string][]backtest.Series{}
+	for _, c := range conditions {
+		formula := c.Formula.ValueString()
+		if _, ok := series[formula]; !ok {
+			requestBody := map[string]interface{}{
+				"timeRange":         timeRange,
+				"dataSource":        monitorTypeSources[kind],
+				"queries":           queries,
+				"aggregationWindow": fmt.Sprintf("%dm", details.Timeframe.ValueInt64()),
+				"formula":           formula,
+			}
+			if len(groupBy) > 0 {
+				requestBody["groupBy"] = groupBy
+			}
+
+			var apiResp timeseriesAggregationAPIResponse
+			diags.Append(d.client.fetchJSON(ctx, http.MethodPost, clusterPath("/v1/aggregation/multi-query/timeseries", clusterID), requestBody, "run timeseries query", &apiResp)...)
+			if diags.HasError() {
+				return backtest.Monitor{}, diags
+			}
+			series[formula] = formulaSeries(apiResp.Data.Series, formula)
+		}
+
+		monitor.Conditions = append(monitor.Conditions, backtest.Condition{
+			Operator:  c.Operator.ValueString(),
+			Threshold: c.Threshold.ValueFloat64(),
+			Series:    series[formula],
+		})
+	}
+	return monitor, diags
+}
+
+// monitorTypeSources maps the threshold monitor configurations to the data source
+// of their queries.
+var monitorTypeSources = map[string]string{
+	"metric": "metrics",
+	"log":    "logs",
+	"trace":  "traces",
+}
+
+// backtestConfiguration returns the threshold configuration set in a monitor
+// configuration and its name, or nil when another type of monitor is set.
+func backtestConfiguration(config resource_monitor.MonitorConfigurationModel) (*resource_monitor.MonitorConfigurationDetailsModel, string) {
+	switch {
+	case config.Metric != nil:
+		return config.Metric, "metric"
+	case config.Log != nil:
+		return config.Log, "log"
+	case config.Trace != nil:
+		return config.Trace, "trace"
+	}
+	return nil, ""
+}
+
+// formulaSeries returns the series of a formula result. The API identifies them as
+// `formula`, or by the query number when the formula is a single query such as q1.
+func formulaSeries(series []timeseriesAPISeries, formula string) []backtest.Series {
+	var out []backtest.Series
+	for _, id := range []string{"formula", formula} {
+		for _, s := range series {
+			if s.ID != id {
+				continue
+			}
+			bs := backtest.Series{Group: flattenGroupLabels(s.Group)}
+			for _, p := range s.Points {
+				bs.Points = append(bs.Points, backtest.Point{Time: time.UnixMilli(p.Timestamp), Value: p.Value})
+			}
+			out = append(out, bs)
+		}
+		if len(out) > 0 {
+			break
+		}
+	}
+	return out
+}
+
+func formatTriggerTimes(triggers []time.Time) []string {
+	out := make([]string, 0, len(triggers))
+	for _, t := range triggers {
+		out = append(out, t.UTC().Format(time.RFC3339))
+	}
+	return out
+}
+
+func triggerTimeBounds(triggers []time.Time) (types.String, types.String) {
+	if len(triggers) == 0 {
+		return types.StringNull(), types.StringNull()
+	}
+	return types.StringValue(triggers[0].UTC().Format(time.RFC3339)), types.StringValue(triggers[len(triggers)-1].UTC().Format(time.RFC3339))
+}
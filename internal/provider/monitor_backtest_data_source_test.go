@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMonitorBacktestDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "tsuga_monitor_backtest" "errors" {
+  window = "1h"
+  to     = "2024-06-01T00:00:00Z"
+
+  configuration = {
+    log = {
+      conditions = [{
+        formula   = "q1"
+        operator  = "greater_than"
+        threshold = 10.0
+      }]
+      no_data_behavior = "resolve"
+      timeframe        = 10
+      group_by_fields = [{
+        fields = ["service"]
+        limit  = 10
+      }]
+      aggregation_alert_logic = "each"
+      queries = [
+        {
+          filter = "level:error"
+          aggregate = {
+            count = {}
+          }
+        }
+      ]
+    }
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.tsuga_monitor_backtest.errors", "evaluations", "6"),
+					resource.TestCheckResourceAttrSet("data.tsuga_monitor_backtest.errors", "trigger_count"),
+					resource.TestCheckResourceAttrSet("data.tsuga_monitor_backtest.errors", "groups.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMonitorBacktestDataSource_Unsupported(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "tsuga_monitor_backtest" "test" {
+  window = "1d"
+
+  configuration = {
+    anomaly_log = {
+      condition = {
+        formula = "q1"
+      }
+      no_data_behavior = "resolve"
+      timeframe        = 10
+      group_by_fields  = []
+      aggregation_alert_logic = "no_aggregation"
+      queries = [
+        {
+          filter = "level:error"
+          aggregate = {
+            count = {}
+          }
+        }
+      ]
+    }
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`Unsupported Monitor Type`),
+			},
+		},
+	})
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"terraform-provider-tsuga/internal/backtest"
+)
+
+func TestFormulaSeries(t *testing.T) {
+	point := []timeseriesAPIPoint{{Timestamp: 1717200000000, Value: 4}}
+	want := []backtest.Series{{
+		Group:  map[string]string{"service": "api"},
+		Points: []backtest.Point{{Time: time.UnixMilli(1717200000000), Value: 4}},
+	}}
+
+	cases := []struct {
+		name    string
+		formula string
+		series  []timeseriesAPISeries
+	}{
+		{"formula result", "q1 / q2", []timeseriesAPISeries{
+			{ID: "q1", Group: map[string]interface{}{"service": "web"}, Points: point},
+			{ID: "formula", Group: map[string]interface{}{"service": "api"}, Points: point},
+		}},
+		{"single query", "q2", []timeseriesAPISeries{
+			{ID: "q1", Group: map[string]interface{}{"service": "web"}, Points: point},
+			{ID: "q2", Group: map[string]interface{}{"service": "api"}, Points: point},
+		}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := formulaSeries(tc.series, tc.formula); !reflect.DeepEqual(got, want) {
+				t.Errorf("formulaSeries() = %+v, want %+v", got, want)
+			}
+		})
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"io"
 	"net/http"
 	"terraform-provider-tsuga/internal/aggregate"
+	"terraform-provider-tsuga/internal/customtypes"
+	"terraform-provider-tsuga/internal/filterexpression"
 	"terraform-provider-tsuga/internal/groupby"
 	"terraform-provider-tsuga/internal/resource_monitor"
 
@@ -15,12 +17,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 var (
 	_ resource.Resource                   = (*monitorResource)(nil)
 	_ resource.ResourceWithConfigure      = (*monitorResource)(nil)
 	_ resource.ResourceWithImportState    = (*monitorResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*monitorResource)(nil)
 	_ resource.ResourceWithValidateConfig = (*monitorResource)(nil)
 )
 
@@ -58,7 +62,7 @@ func (r *monitorResource) Metadata(_ context.Context, req resource.MetadataReque
 }
 
 func (r *monitorResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = resource_monitor.MonitorResourceSchema(ctx)
+	resp.Schema = withTimeouts(ctx, withDeletionProtection(resource_monitor.MonitorResourceSchema(ctx)))
 }
 
 func (r *monitorResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -107,28 +111,28 @@ func (r *monitorResource) ValidateConfig(ctx context.Context, req resource.Valid
 
 	// Validate proportion_alert_threshold is set when aggregation_alert_logic is "proportion"
 	if config.Configuration.Metric != nil {
-		diags.Append(r.validateProportionAlertConfig(config.Configuration.Metric.AggregationAlertLogic, config.Configuration.Metric.ProportionAlertThreshold, "configuration.metric")...)
-		diags.Append(r.validateQueries(ctx, config.Configuration.Metric.Queries, "configuration.metric.queries")...)
+		diags.Append(validateThresholdMonitorConfig(ctx, config.Configuration.Metric, "configuration.metric")...)
 	}
 	if config.Configuration.Log != nil {
-		diags.Append(r.validateProportionAlertConfig(config.Configuration.Log.AggregationAlertLogic, config.Configuration.Log.ProportionAlertThreshold, "configuration.log")...)
-		diags.Append(r.validateQueries(ctx, config.Configuration.Log.Queries, "configuration.log.queries")...)
+		diags.Append(validateThresholdMonitorConfig(ctx, config.Configuration.Log, "configuration.log")...)
 	}
 	if config.Configuration.Trace != nil {
-		diags.Append(r.validateProportionAlertConfig(config.Configuration.Trace.AggregationAlertLogic, config.Configuration.Trace.ProportionAlertThreshold, "configuration.trace")...)
-		diags.Append(r.validateQueries(ctx, config.Configuration.Trace.Queries, "configuration.trace.queries")...)
+		diags.Append(validateThresholdMonitorConfig(ctx, config.Configuration.Trace, "configuration.trace")...)
 	}
 	if config.Configuration.AnomalyMetric != nil {
-		diags.Append(r.validateProportionAlertConfig(config.Configuration.AnomalyMetric.AggregationAlertLogic, config.Configuration.AnomalyMetric.ProportionAlertThreshold, "configuration.anomaly_metric")...)
-		diags.Append(r.validateQueries(ctx, config.Configuration.AnomalyMetric.Queries, "configuration.anomaly_metric.queries")...)
+		diags.Append(validateProportionAlertConfig(config.Configuration.AnomalyMetric.AggregationAlertLogic, config.Configuration.AnomalyMetric.ProportionAlertThreshold, "configuration.anomaly_metric")...)
+		formulas := []formulaAttribute{{value: config.Configuration.AnomalyMetric.Condition.Formula, path: "configuration.anomaly_metric.condition.formula"}}
+		diags.Append(validateMonitorQueries(ctx, config.Configuration.AnomalyMetric.Queries, formulas, "configuration.anomaly_metric.queries")...)
 	}
 	if config.Configuration.AnomalyLog != nil {
-		diags.Append(r.validateProportionAlertConfig(config.Configuration.AnomalyLog.AggregationAlertLogic, config.Configuration.AnomalyLog.ProportionAlertThreshold, "configuration.anomaly_log")...)
-		diags.Append(r.validateQueries(ctx, config.Configuration.AnomalyLog.Queries, "configuration.anomaly_log.queries")...)
+		diags.Append(validateProportionAlertConfig(config.Configuration.AnomalyLog.AggregationAlertLogic, config.Configuration.AnomalyLog.ProportionAlertThreshold, "configuration.anomaly_log")...)
+		formulas := []formulaAttribute{{value: config.Configuration.AnomalyLog.Condition.Formula, path: "configuration.anomaly_log.condition.formula"}}
+		diags.Append(validateMonitorQueries(ctx, config.Configuration.AnomalyLog.Queries, formulas, "configuration.anomaly_log.queries")...)
 	}
 	if config.Configuration.AnomalyTrace != nil {
-		diags.Append(r.validateProportionAlertConfig(config.Configuration.AnomalyTrace.AggregationAlertLogic, config.Configuration.AnomalyTrace.ProportionAlertThreshold, "configuration.anomaly_trace")...)
-		diags.Append(r.validateQueries(ctx, config.Configuration.AnomalyTrace.Queries, "configuration.anomaly_trace.queries")...)
+		diags.Append(validateProportionAlertConfig(config.Configuration.AnomalyTrace.AggregationAlertLogic, config.Configuration.AnomalyTrace.ProportionAlertThreshold, "configuration.anomaly_trace")...)
+		formulas := []formulaAttribute{{value: config.Configuration.AnomalyTrace.Condition.Formula, path: "configuration.anomaly_trace.condition.formula"}}
+		diags.Append(validateMonitorQueries(ctx, config.Configuration.AnomalyTrace.Queries, formulas, "configuration.anomaly_trace.queries")...)
 	}
 	if config.Configuration.CertificateExpiry != nil {
 		diags.Append(r.validateCertificateExpiryConfig(
@@ -143,7 +147,7 @@ func (r *monitorResource) ValidateConfig(ctx context.Context, req resource.Valid
 	resp.Diagnostics.Append(diags...)
 }
 
-func (r *monitorResource) validateProportionAlertConfig(aggregationAlertLogic types.String, proportionAlertThreshold types.Int64, pathPrefix string) diag.Diagnostics {
+func validateProportionAlertConfig(aggregationAlertLogic types.String, proportionAlertThreshold types.Int64, pathPrefix string) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	if !aggregationAlertLogic.IsNull() && !aggregationAlertLogic.IsUnknown() {
@@ -199,7 +203,49 @@ func (r *monitorResource) validateCertificateExpiryConfig(
 	return diags
 }
 
-func (r *monitorResource) validateQueries(ctx context.Context, queries types.List, pathPrefix string) diag.Diagnostics {
+// validateThresholdMonitorConfig validates the proportion threshold, queries and
+// condition formulas of a metric, log or trace monitor configuration.
+func validateThresholdMonitorConfig(ctx context.Context, details *resource_monitor.MonitorConfigurationDetailsModel, pathPrefix string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(validateProportionAlertConfig(details.AggregationAlertLogic, details.ProportionAlertThreshold, pathPrefix)...)
+	formulas, fDiags := monitorConditionFormulas(ctx, details, pathPrefix)
+	diags.Append(fDiags...)
+	diags.Append(validateMonitorQueries(ctx, details.Queries, formulas, pathPrefix+".queries")...)
+
+	return diags
+}
+
+// monitorConditionFormulas collects the formulas of the conditions, and of the deprecated
+// condition block, of a metric, log or trace monitor.
+func monitorConditionFormulas(ctx context.Context, details *resource_monitor.MonitorConfigurationDetailsModel, pathPrefix string) ([]formulaAttribute, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var formulas []formulaAttribute
+
+	if details.Conditions.IsUnknown() || details.Condition.IsUnknown() {
+		// Which queries are used is not known yet
+		return []formulaAttribute{{value: types.StringUnknown(), path: pathPrefix + ".conditions"}}, diags
+	}
+
+	if !details.Conditions.IsNull() {
+		var conditions []resource_monitor.MonitorConditionModel
+		diags.Append(details.Conditions.ElementsAs(ctx, &conditions, false)...)
+		for i, c := range conditions {
+			formulas = append(formulas, formulaAttribute{value: c.Formula, path: fmt.Sprintf("%s.conditions[%d].formula", pathPrefix, i)})
+		}
+	}
+
+	if !details.Condition.IsNull() {
+		var condition resource_monitor.MonitorConditionModel
+		diags.Append(details.Condition.As(ctx, &condition, basetypes.ObjectAsOptions{})...)
+		formulas = append(formulas, formulaAttribute{value: condition.Formula, path: pathPrefix + ".condition.formula"})
+	}
+
+	return formulas, diags
+}
+
+// validateMonitorQueries validates each query and the formulas that combine them.
+func validateMonitorQueries(ctx context.Context, queries types.List, formulas []formulaAttribute, pathPrefix string) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	if queries.IsNull() || queries.IsUnknown() {
@@ -213,13 +259,17 @@ func (r *monitorResource) validateQueries(ctx context.Context, queries types.Lis
 	}
 
 	for i, query := range queryModels {
-		diags.Append(r.validateAggregate(query.Aggregate, fmt.Sprintf("%s[%d].aggregate", pathPrefix, i))...)
+		diags.Append(validateQuerySyntax(query.Filter.StringValue, fmt.Sprintf("%s[%d].filter", pathPrefix, i))...)
+		diags.Append(validateFilterExpression(ctx, query.FilterExpression, fmt.Sprintf("%s[%d].filter_expression", pathPrefix, i))...)
+		diags.Append(validateMonitorAggregate(query.Aggregate, fmt.Sprintf("%s[%d].aggregate", pathPrefix, i))...)
 	}
 
+	diags.Append(validateFormulas(formulas, len(queryModels), pathPrefix)...)
+
 	return diags
 }
 
-func (r *monitorResource) validateAggregate(agg resource_monitor.MonitorAggregateModel, pathPrefix string) diag.Diagnostics {
+func validateMonitorAggregate(agg resource_monitor.MonitorAggregateModel, pathPrefix string) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	setCount := 0
@@ -259,6 +309,12 @@ func (r *monitorResource) ImportState(ctx context.Context, req resource.ImportSt
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *monitorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnDeletionProtection(ctx, "monitor", req, resp)
+	planDefaultClusterIDs(ctx, r.client, req, resp)
+	warnMetricQueries(ctx, r.client, req, resp, monitorMetricQueries)
+}
+
 func (r *monitorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan resource_monitor.MonitorModel
 
@@ -267,19 +323,40 @@ func (r *monitorResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	requestBody, diags := r.buildMonitorRequestBody(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	newState, diags := r.createOrUpdateMonitor(ctx, http.MethodPost, "/v1/monitors", requestBody, "create")
+	key, diags := newIdempotencyKey("tsuga_monitor")
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withIdempotencyKey(ctx, key)
 
+	newState, apiData, diags := r.createOrUpdateMonitor(ctx, http.MethodPost, "/v1/monitors", requestBody, "create")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState.DeletionProtection = plan.DeletionProtection
+	newState.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(setRemoteSnapshot(ctx, resp.Private, apiData)...)
+	resp.Diagnostics.Append(setIdempotencyKey(ctx, resp.Private, key)...)
+	resp.Diagnostics.Append(preserveFilterExpressions(req.Plan.Raw, &resp.State)...)
 }
 
 func (r *monitorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -289,43 +366,37 @@ func (r *monitorResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	path := fmt.Sprintf("/v1/monitors/%s", state.Id.ValueString())
-	httpResp, err := r.client.doRequest(ctx, http.MethodGet, path, nil)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read monitor: %s", err))
-		return
-	}
-	defer func() { _ = httpResp.Body.Close() }()
-
-	if httpResp.StatusCode == http.StatusNotFound {
-		resp.State.RemoveResource(ctx)
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if err := r.client.checkResponse(httpResp); err != nil {
-		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read monitor: %s", err))
-		return
-	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
 
-	raw, err := io.ReadAll(httpResp.Body)
-	if err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to read response body: %s", err))
+	data, found, diags := r.fetchMonitor(ctx, state.Id.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var apiResp monitorAPIResponse
-	if err := json.Unmarshal(raw, &apiResp); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err))
+	if !found {
+		resp.State.RemoveResource(ctx)
 		return
 	}
 
-	newState, diags := flattenMonitor(ctx, apiResp.Data)
+	newState, diags := flattenMonitor(ctx, data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	newState.DeletionProtection = state.DeletionProtection
+	newState.Timeouts = state.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(setRemoteSnapshot(ctx, resp.Private, data)...)
+	resp.Diagnostics.Append(preserveFilterExpressions(req.State.Raw, &resp.State)...)
 }
 
 func (r *monitorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -338,6 +409,28 @@ func (r *monitorResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Refuse to overwrite changes made since the plan, such as in the Tsuga UI.
+	current, found, diags := r.fetchMonitor(ctx, state.Id.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if found {
+		resp.Diagnostics.Append(checkRemoteUnchanged(ctx, req.Private, "monitor", state.Id.ValueString(), current)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	requestBody, diags := r.buildMonitorRequestBody(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -345,13 +438,17 @@ func (r *monitorResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	path := fmt.Sprintf("/v1/monitors/%s", state.Id.ValueString())
-	newState, diags := r.createOrUpdateMonitor(ctx, http.MethodPut, path, requestBody, "update")
+	newState, apiData, diags := r.createOrUpdateMonitor(ctx, http.MethodPut, path, requestBody, "update")
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	newState.DeletionProtection = plan.DeletionProtection
+	newState.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(setRemoteSnapshot(ctx, resp.Private, apiData)...)
+	resp.Diagnostics.Append(preserveFilterExpressions(req.Plan.Raw, &resp.State)...)
 }
 
 func (r *monitorResource) buildMonitorRequestBody(ctx context.Context, plan resource_monitor.MonitorModel) (map[string]interface{}, diag.Diagnostics) {
@@ -396,40 +493,81 @@ func (r *monitorResource) buildMonitorRequestBody(ctx context.Context, plan reso
 	return body, diags
 }
 
-func (r *monitorResource) createOrUpdateMonitor(ctx context.Context, method, path string, requestBody map[string]interface{}, operation string) (resource_monitor.MonitorModel, diag.Diagnostics) {
+func (r *monitorResource) createOrUpdateMonitor(ctx context.Context, method, path string, requestBody map[string]interface{}, operation string) (resource_monitor.MonitorModel, monitorAPIData, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	httpResp, err := r.client.doRequest(ctx, method, path, requestBody)
+	var httpResp *http.Response
+	var err error
+	if method == http.MethodPost {
+		httpResp, err = r.client.createIdempotently(ctx, path, requestBody)
+	} else {
+		httpResp, err = r.client.doRequest(ctx, method, path, requestBody)
+	}
 	if err != nil {
 		diags.AddError("Client Error", fmt.Sprintf("Unable to %s monitor: %s", operation, err))
-		return resource_monitor.MonitorModel{}, diags
+		return resource_monitor.MonitorModel{}, monitorAPIData{}, diags
 	}
 	defer func() { _ = httpResp.Body.Close() }()
 
 	if err := r.client.checkResponse(httpResp); err != nil {
 		diags.AddError("API Error", fmt.Sprintf("Unable to %s monitor: %s", operation, err))
-		return resource_monitor.MonitorModel{}, diags
+		return resource_monitor.MonitorModel{}, monitorAPIData{}, diags
 	}
 
 	raw, err := io.ReadAll(httpResp.Body)
 	if err != nil {
 		diags.AddError("Parse Error", fmt.Sprintf("Unable to read response body: %s", err))
-		return resource_monitor.MonitorModel{}, diags
+		return resource_monitor.MonitorModel{}, monitorAPIData{}, diags
 	}
 
 	var apiResp monitorAPIResponse
 	if err := json.Unmarshal(raw, &apiResp); err != nil {
 		diags.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err))
-		return resource_monitor.MonitorModel{}, diags
+		return resource_monitor.MonitorModel{}, monitorAPIData{}, diags
 	}
 
 	newState, flattenDiags := flattenMonitor(ctx, apiResp.Data)
 	diags.Append(flattenDiags...)
 	if diags.HasError() {
-		return resource_monitor.MonitorModel{}, diags
+		return resource_monitor.MonitorModel{}, monitorAPIData{}, diags
 	}
 
-	return newState, diags
+	return newState, apiResp.Data, diags
+}
+
+// fetchMonitor reads a monitor, with found false when it no longer exists.
+func (r *monitorResource) fetchMonitor(ctx context.Context, id string) (monitorAPIData, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	httpResp, err := r.client.doRequest(ctx, http.MethodGet, fmt.Sprintf("/v1/monitors/%s", id), nil)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read monitor: %s", err))
+		return monitorAPIData{}, false, diags
+	}
+	defer func() { _ = httpResp.Body.Close() }()
+
+	if httpResp.StatusCode == http.StatusNotFound {
+		return monitorAPIData{}, false, diags
+	}
+
+	if err := r.client.checkResponse(httpResp); err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Unable to read monitor: %s", err))
+		return monitorAPIData{}, false, diags
+	}
+
+	raw, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		diags.AddError("Parse Error", fmt.Sprintf("Unable to read response body: %s", err))
+		return monitorAPIData{}, false, diags
+	}
+
+	var apiResp monitorAPIResponse
+	if err := json.Unmarshal(raw, &apiResp); err != nil {
+		diags.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err))
+		return monitorAPIData{}, false, diags
+	}
+
+	return apiResp.Data, true, diags
 }
 
 func (r *monitorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -439,6 +577,20 @@ func (r *monitorResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.Append(deletionProtectionError("monitor", state.Id))
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	path := fmt.Sprintf("/v1/monitors/%s", state.Id.ValueString())
 	httpResp, err := r.client.doRequest(ctx, http.MethodDelete, path, map[string]interface{}{})
 	if err != nil {
@@ -870,9 +1022,11 @@ func expandMonitorQueries(ctx context.Context, queries types.List) ([]map[string
 		diags.Append(fDiags...)
 		fill, fillDiags := expandAggregationFill(q.Fill)
 		diags.Append(fillDiags...)
+		filter, filterDiags := resolveQuery(ctx, q.Filter.StringValue, q.FilterExpression)
+		diags.Append(filterDiags...)
 
 		query := map[string]interface{}{
-			"filter":    q.Filter.ValueString(),
+			"filter":    filter,
 			"aggregate": agg,
 		}
 
@@ -1138,9 +1292,9 @@ func flattenMonitorConditions(conditions []monitorAPICondition) (types.List, dia
 
 	values := make([]attr.Value, 0, len(conditions))
 	for _, c := range conditions {
-		threshold := types.Float64Null()
+		threshold := customtypes.NewFloat64Null()
 		if c.Threshold != nil {
-			threshold = types.Float64Value(*c.Threshold)
+			threshold = customtypes.NewFloat64Value(*c.Threshold)
 		}
 
 		values = append(values, types.ObjectValueMust(resource_monitor.MonitorConditionAttrTypes(), map[string]attr.Value{
@@ -1296,11 +1450,12 @@ func flattenMonitorQueries(queries []monitorAPIQuery) (types.List, diag.Diagnost
 		}
 
 		obj := map[string]attr.Value{
-			"filter":         types.StringValue(q.Filter),
-			"aggregate":      aggVal,
-			"functions":      functionsVal,
-			"fill":           fillVal,
-			"time_aggregate": stringValueOrNull(q.TimeAggregate),
+			"filter":            customtypes.NewQueryValue(q.Filter),
+			"filter_expression": filterexpression.Null(),
+			"aggregate":         aggVal,
+			"functions":         functionsVal,
+			"fill":              fillVal,
+			"time_aggregate":    stringValueOrNull(q.TimeAggregate),
 		}
 
 		values = append(values, types.ObjectValueMust(resource_monitor.QueryAttrTypes(), obj))
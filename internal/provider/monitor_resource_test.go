@@ -5,6 +5,9 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
 
 func TestAccMonitorResource(t *testing.T) {
@@ -139,6 +142,64 @@ resource "tsuga_monitor" "test" {
 					resource.TestCheckResourceAttr("tsuga_monitor.test", "configuration.log.queries.#", "2"),
 				),
 			},
+			// Changing only the priority plans an in-place update that keeps the
+			// computed id known.
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "tsuga_team" "test-team" {
+  name = "%s"
+  visibility = "public"
+}
+
+resource "tsuga_monitor" "test" {
+  name        = "test-monitor-updated"
+  owner       = tsuga_team.test-team.id
+  priority    = 2
+  permissions = "owning-team-only"
+  message     = "Updated monitor message"
+
+  configuration = {
+    log = {
+      conditions = [{
+        formula   = "q1 + q2"
+        operator  = "less_than"
+        threshold = 5.0
+      }]
+      no_data_behavior        = "resolve"
+      timeframe               = 10
+      group_by_fields = [{
+        fields = ["service", "env"]
+        limit  = 10
+      }]
+      aggregation_alert_logic = "each"
+      queries = [
+        {
+          filter = "service:web"
+          aggregate = {
+            count = {}
+          }
+        },
+        {
+          filter = "env:prod"
+          aggregate = {
+            unique_count = {
+              field = "user"
+            }
+          }
+        }
+      ]
+    }
+  }
+}
+`, teamName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("tsuga_monitor.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("tsuga_monitor.test", tfjsonpath.New("id"), knownvalue.NotNull()),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("tsuga_monitor.test", "priority", "2"),
+			},
 		},
 	})
 }
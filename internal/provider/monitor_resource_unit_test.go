@@ -3,6 +3,8 @@ package provider
 import (
 	"context"
 	"terraform-provider-tsuga/internal/aggregate"
+	"terraform-provider-tsuga/internal/customtypes"
+	"terraform-provider-tsuga/internal/filterexpression"
 	"terraform-provider-tsuga/internal/groupby"
 	"terraform-provider-tsuga/internal/resource_monitor"
 	"testing"
@@ -246,10 +248,11 @@ func TestExpandFlattenAnomalyTraceMonitor_RoundTrips(t *testing.T) {
 
 	queries, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: resource_monitor.QueryAttrTypes()},
 		[]resource_monitor.MonitorQueryModel{{
-			Filter:        types.StringValue("service:api"),
-			Aggregate:     resource_monitor.MonitorAggregateModel{Count: &aggregate.CountModel{Field: types.StringNull()}},
-			Functions:     types.ListNull(types.ObjectType{AttrTypes: resource_monitor.AggregationFunctionAttrTypes()}),
-			TimeAggregate: types.StringValue("max"),
+			Filter:           customtypes.NewQueryValue("service:api"),
+			FilterExpression: filterexpression.Null(),
+			Aggregate:        resource_monitor.MonitorAggregateModel{Count: &aggregate.CountModel{Field: types.StringNull()}},
+			Functions:        types.ListNull(types.ObjectType{AttrTypes: resource_monitor.AggregationFunctionAttrTypes()}),
+			TimeAggregate:    types.StringValue("max"),
 		}})
 	if diags.HasError() {
 		t.Fatalf("failed to build queries: %v", diags)
@@ -7,6 +7,8 @@ import (
 	"io"
 	"net/http"
 
+	"terraform-provider-tsuga/internal/customtypes"
+	"terraform-provider-tsuga/internal/filterexpression"
 	"terraform-provider-tsuga/internal/resource_notification_rule"
 	"terraform-provider-tsuga/internal/teamsfilter"
 
@@ -20,6 +22,7 @@ import (
 var _ resource.Resource = (*notificationRuleResource)(nil)
 var _ resource.ResourceWithConfigure = (*notificationRuleResource)(nil)
 var _ resource.ResourceWithImportState = (*notificationRuleResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*notificationRuleResource)(nil)
 var _ resource.ResourceWithValidateConfig = (*notificationRuleResource)(nil)
 
 func NewNotificationRuleResource() resource.Resource {
@@ -52,7 +55,7 @@ func (r *notificationRuleResource) Metadata(_ context.Context, req resource.Meta
 }
 
 func (r *notificationRuleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = resource_notification_rule.NotificationRuleResourceSchema(ctx)
+	resp.Schema = withTimeouts(ctx, withOnDestroy(withDeletionProtection(resource_notification_rule.NotificationRuleResourceSchema(ctx)), "is_active"))
 }
 
 func (r *notificationRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -62,6 +65,9 @@ func (r *notificationRuleResource) ValidateConfig(ctx context.Context, req resou
 		return
 	}
 
+	resp.Diagnostics.Append(validateQuerySyntax(config.QueryString.StringValue, "query_string")...)
+	resp.Diagnostics.Append(validateFilterExpression(ctx, config.FilterExpression, "filter_expression")...)
+
 	// Validate teams_filter: teams is required when type is "specific-teams"
 	if config.TeamsFilter != nil && !config.TeamsFilter.Type.IsNull() && !config.TeamsFilter.Type.IsUnknown() {
 		filterType := config.TeamsFilter.Type.ValueString()
@@ -140,6 +146,10 @@ func (r *notificationRuleResource) ImportState(ctx context.Context, req resource
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *notificationRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnDeletionProtection(ctx, "notification rule", req, resp)
+}
+
 func (r *notificationRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan resource_notification_rule.NotificationRuleModel
 
@@ -148,6 +158,15 @@ func (r *notificationRuleResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	requestBody, diags := r.buildNotificationRuleRequestBody(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -160,7 +179,11 @@ func (r *notificationRuleResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	newState.OnDestroy = plan.OnDestroy
+	newState.DeletionProtection = plan.DeletionProtection
+	newState.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(preserveFilterExpressions(req.Plan.Raw, &resp.State)...)
 }
 
 func (r *notificationRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -171,6 +194,15 @@ func (r *notificationRuleResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	path := fmt.Sprintf("/v1/notification-rules/%s", state.Id.ValueString())
 	httpResp, err := r.client.doRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -207,7 +239,11 @@ func (r *notificationRuleResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	newState.OnDestroy = state.OnDestroy
+	newState.DeletionProtection = state.DeletionProtection
+	newState.Timeouts = state.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(preserveFilterExpressions(req.State.Raw, &resp.State)...)
 }
 
 func (r *notificationRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -220,6 +256,15 @@ func (r *notificationRuleResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	requestBody, diags := r.buildNotificationRuleRequestBody(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -233,7 +278,11 @@ func (r *notificationRuleResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	newState.OnDestroy = plan.OnDestroy
+	newState.DeletionProtection = plan.DeletionProtection
+	newState.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(preserveFilterExpressions(req.Plan.Raw, &resp.State)...)
 }
 
 func (r *notificationRuleResource) buildNotificationRuleRequestBody(ctx context.Context, plan resource_notification_rule.NotificationRuleModel) (map[string]any, diag.Diagnostics) {
@@ -247,6 +296,8 @@ func (r *notificationRuleResource) buildNotificationRuleRequestBody(ctx context.
 	diags.Append(expandDiags...)
 	targets, expandDiags := expandNotificationRuleTargets(ctx, plan.Targets)
 	diags.Append(expandDiags...)
+	queryString, expandDiags := resolveQuery(ctx, plan.QueryString.StringValue, plan.FilterExpression)
+	diags.Append(expandDiags...)
 	if diags.HasError() {
 		return nil, diags
 	}
@@ -265,7 +316,7 @@ func (r *notificationRuleResource) buildNotificationRuleRequestBody(ctx context.
 	// omitted optional fields on update, so omitting it would leave a previously
 	// set query_string in place instead of clearing it.
 	if !plan.QueryString.IsUnknown() {
-		requestBody["queryString"] = plan.QueryString.ValueString()
+		requestBody["queryString"] = queryString
 	}
 
 	if tags, tagDiags := expandTags(ctx, plan.Tags); tagDiags.HasError() {
@@ -321,7 +372,37 @@ func (r *notificationRuleResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.Append(deletionProtectionError("notification rule", state.Id))
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	path := fmt.Sprintf("/v1/notification-rules/%s", state.Id.ValueString())
+
+	switch state.OnDestroy.ValueString() {
+	case onDestroyAbandon:
+		return
+	case onDestroyDisable:
+		state.IsActive = types.BoolValue(false)
+		requestBody, diags := r.buildNotificationRuleRequestBody(ctx, state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(disableOnDestroy(ctx, r.client, path, requestBody, "notification rule")...)
+		return
+	}
+
 	httpResp, err := r.client.doRequest(ctx, http.MethodDelete, path, map[string]interface{}{})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete notification rule: %s", err))
@@ -454,7 +535,8 @@ func flattenNotificationRule(ctx context.Context, data notificationRuleAPIData)
 	state := resource_notification_rule.NotificationRuleModel{
 		Id:                    types.StringValue(data.ID),
 		Name:                  types.StringValue(data.Name),
-		QueryString:           stringValueOrNull(data.QueryString),
+		QueryString:           customtypes.QueryValue{StringValue: stringValueOrNull(data.QueryString)},
+		FilterExpression:      filterexpression.Null(),
 		TeamsFilter:           teamsFilter,
 		PrioritiesFilter:      prioritiesFilter,
 		TransitionTypesFilter: transitionTypesFilter,
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"time"
 
+	"terraform-provider-tsuga/internal/filterexpression"
 	"terraform-provider-tsuga/internal/resource_notification_silence"
 	"terraform-provider-tsuga/internal/teamsfilter"
 
@@ -53,7 +54,7 @@ func (r *notificationSilenceResource) Metadata(_ context.Context, req resource.M
 }
 
 func (r *notificationSilenceResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = resource_notification_silence.NotificationSilenceResourceSchema(ctx)
+	resp.Schema = withTimeouts(ctx, withOnDestroy(resource_notification_silence.NotificationSilenceResourceSchema(ctx), "is_active"))
 }
 
 func (r *notificationSilenceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -93,6 +94,9 @@ func (r *notificationSilenceResource) ValidateConfig(ctx context.Context, req re
 		}
 	}
 
+	resp.Diagnostics.Append(validateQuerySyntax(config.QueryString, "query_string")...)
+	resp.Diagnostics.Append(validateFilterExpression(ctx, config.FilterExpression, "filter_expression")...)
+
 	// Validate teams_filter: teams is required when type is "specific-teams"
 	if config.TeamsFilter != nil && !config.TeamsFilter.Type.IsNull() && !config.TeamsFilter.Type.IsUnknown() {
 		filterType := config.TeamsFilter.Type.ValueString()
@@ -213,6 +217,15 @@ func (r *notificationSilenceResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	requestBody, diags := r.buildNotificationSilenceRequestBody(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -225,7 +238,10 @@ func (r *notificationSilenceResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	newState.OnDestroy = plan.OnDestroy
+	newState.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(preserveFilterExpressions(req.Plan.Raw, &resp.State)...)
 }
 
 func (r *notificationSilenceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -236,6 +252,15 @@ func (r *notificationSilenceResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	path := fmt.Sprintf("/v1/notification-silences/%s", state.Id.ValueString())
 	httpResp, err := r.client.doRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -272,7 +297,10 @@ func (r *notificationSilenceResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	newState.OnDestroy = state.OnDestroy
+	newState.Timeouts = state.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(preserveFilterExpressions(req.State.Raw, &resp.State)...)
 }
 
 func (r *notificationSilenceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -285,6 +313,15 @@ func (r *notificationSilenceResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	requestBody, diags := r.buildNotificationSilenceRequestBody(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -298,7 +335,10 @@ func (r *notificationSilenceResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	newState.OnDestroy = plan.OnDestroy
+	newState.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(preserveFilterExpressions(req.Plan.Raw, &resp.State)...)
 }
 
 func (r *notificationSilenceResource) buildNotificationSilenceRequestBody(ctx context.Context, plan resource_notification_silence.NotificationSilenceModel) (map[string]any, diag.Diagnostics) {
@@ -314,6 +354,8 @@ func (r *notificationSilenceResource) buildNotificationSilenceRequestBody(ctx co
 	diags.Append(expandDiags...)
 	notificationRuleIds, expandDiags := expandStringList(ctx, plan.NotificationRuleIds)
 	diags.Append(expandDiags...)
+	queryString, expandDiags := resolveQuery(ctx, plan.QueryString, plan.FilterExpression)
+	diags.Append(expandDiags...)
 	if diags.HasError() {
 		return nil, diags
 	}
@@ -336,8 +378,8 @@ func (r *notificationSilenceResource) buildNotificationSilenceRequestBody(ctx co
 		requestBody["notificationRuleIds"] = notificationRuleIds
 	}
 
-	if !plan.QueryString.IsNull() && !plan.QueryString.IsUnknown() {
-		requestBody["queryString"] = plan.QueryString.ValueString()
+	if queryString != "" {
+		requestBody["queryString"] = queryString
 	}
 
 	if tags, tagDiags := expandTags(ctx, plan.Tags); tagDiags.HasError() {
@@ -393,7 +435,32 @@ func (r *notificationSilenceResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	path := fmt.Sprintf("/v1/notification-silences/%s", state.Id.ValueString())
+
+	switch state.OnDestroy.ValueString() {
+	case onDestroyAbandon:
+		return
+	case onDestroyDisable:
+		state.IsActive = types.BoolValue(false)
+		requestBody, diags := r.buildNotificationSilenceRequestBody(ctx, state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(disableOnDestroy(ctx, r.client, path, requestBody, "notification silence")...)
+		return
+	}
+
 	httpResp, err := r.client.doRequest(ctx, http.MethodDelete, path, map[string]interface{}{})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete notification silence: %s", err))
@@ -554,6 +621,7 @@ func flattenNotificationSilence(ctx context.Context, data notificationSilenceAPI
 		Schedule:              schedule,
 		NotificationRuleIds:   notificationRuleIds,
 		QueryString:           stringValueOrNull(data.QueryString),
+		FilterExpression:      filterexpression.Null(),
 		TeamsFilter:           teamsFilter,
 		PrioritiesFilter:      prioritiesFilter,
 		TransitionTypesFilter: transitionTypesFilter,
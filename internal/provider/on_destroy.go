@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Values of `on_destroy`, which selects what Delete does to a resource that has
+// an activation flag. A null value deletes it.
+const (
+	onDestroyDelete  = "delete"
+	onDestroyDisable = "disable"
+	onDestroyAbandon = "abandon"
+)
+
+// withOnDestroy adds the `on_destroy` attribute to a resource schema. flag names
+// the boolean attribute that `disable` sets to false.
+func withOnDestroy(s schema.Schema, flag string) schema.Schema {
+	s.Attributes["on_destroy"] = schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(onDestroyDelete, onDestroyDisable, onDestroyAbandon),
+		},
+		Description: fmt.Sprintf("What destroying the resource does: `delete` (the default) deletes it, `disable` sets `%s` to `false` and leaves it in place, "+
+			"and `abandon` leaves it untouched. With `disable` and `abandon` the resource keeps its ID and history and is only removed from Terraform state, "+
+			"so it can be imported again. The value in state applies, so apply a change to it before removing the resource from the configuration.", flag),
+	}
+	return s
+}
+
+// disableOnDestroy sends the PUT that disables a resource with `on_destroy =
+// "disable"`. As when deleting, an object already removed outside Terraform is
+// not an error. kind names the resource in errors, such as "route".
+func disableOnDestroy(ctx context.Context, client *TsugaClient, path string, requestBody interface{}, kind string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	httpResp, err := client.doRequest(ctx, http.MethodPut, path, requestBody)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to disable %s: %s", kind, err))
+		return diags
+	}
+	defer func() { _ = httpResp.Body.Close() }()
+
+	if httpResp.StatusCode != http.StatusNotFound {
+		if err := client.checkResponse(httpResp); err != nil {
+			diags.AddError("API Error", fmt.Sprintf("Unable to disable %s: %s", kind, err))
+		}
+	}
+	return diags
+}
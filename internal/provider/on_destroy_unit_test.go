@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDisableOnDestroy(t *testing.T) {
+	cases := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{name: "disabled", status: http.StatusOK},
+		{name: "already removed", status: http.StatusNotFound},
+		{name: "API error", status: http.StatusInternalServerError, wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPut || r.URL.Path != "/v1/routes/r1" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(`{"data":{}}`))
+			}))
+			defer server.Close()
+			client := &TsugaClient{BaseURL: server.URL, client: server.Client()}
+
+			diags := disableOnDestroy(context.Background(), client, "/v1/routes/r1", map[string]interface{}{"isEnabled": false}, "route")
+			if diags.HasError() != tc.wantErr {
+				t.Errorf("disableOnDestroy() diagnostics = %v, want error %v", diags, tc.wantErr)
+			}
+		})
+	}
+}
@@ -2,9 +2,13 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -12,6 +16,7 @@ import (
 )
 
 var _ provider.Provider = (*tsugaProvider)(nil)
+var _ provider.ProviderWithEphemeralResources = (*tsugaProvider)(nil)
 
 func New(version, commit, date string) func() provider.Provider {
 	return func() provider.Provider {
@@ -30,8 +35,11 @@ type tsugaProvider struct {
 }
 
 type tsugaProviderModel struct {
-	BaseURL types.String `tfsdk:"base_url"`
-	Token   types.String `tfsdk:"token"`
+	BaseURL            types.String `tfsdk:"base_url"`
+	Token              types.String `tfsdk:"token"`
+	ConsistencyTimeout types.String `tfsdk:"consistency_timeout"`
+	ClusterID          types.String `tfsdk:"cluster_id"`
+	ValidateMetrics    types.Bool   `tfsdk:"validate_metrics"`
 }
 
 func (p *tsugaProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
@@ -46,6 +54,21 @@ func (p *tsugaProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 				Sensitive:   true,
 				Description: "Bearer token for API authentication. Defaults to TSUGA_TOKEN environment variable.",
 			},
+			"cluster_id": schema.StringAttribute{
+				Optional: true,
+				Description: "Default Tsuga cluster, as listed by the `tsuga_clusters` data source. It is the `cluster_ids` of `tsuga_monitor` and `tsuga_slo` resources that omit them, and the `clusterId` of telemetry requests, such as those of data sources reading services, metrics or logs, that set no cluster of their own. " +
+					"Defaults to TSUGA_CLUSTER_ID environment variable. When unset, monitors and SLOs apply to all clusters and telemetry requests use the API's default cluster.",
+			},
+			"validate_metrics": schema.BoolAttribute{
+				Optional: true,
+				Description: "Check the metrics queries of `tsuga_monitor` and `tsuga_dashboard` resources at plan time, warning when a query references a metric not reported in the last 7 days, or applies a counter function such as `rate` or `per_second` to a gauge. " +
+					"The metrics are listed once per plan, in the provider's `cluster_id`. Defaults to TSUGA_VALIDATE_METRICS environment variable, or false if not set.",
+			},
+			"consistency_timeout": schema.StringAttribute{
+				Optional: true,
+				Description: "How long resources that wait for their writes to become readable, such as `tsuga_team` and `tsuga_team_membership`, poll the API after creating or updating an object, as a duration such as `\"30s\"` or `\"5m\"`. " +
+					"Set it to `\"0s\"` to disable the wait. Defaults to TSUGA_CONSISTENCY_TIMEOUT environment variable, or 2 minutes if not set. The resource's `timeouts` still bound the whole operation.",
+			},
 		},
 	}
 }
@@ -74,6 +97,42 @@ func (p *tsugaProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		token = config.Token.ValueString()
 	}
 
+	clusterID := os.Getenv("TSUGA_CLUSTER_ID")
+	if !config.ClusterID.IsNull() {
+		clusterID = config.ClusterID.ValueString()
+	}
+
+	validateMetrics := false
+	if v := os.Getenv("TSUGA_VALIDATE_METRICS"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid Validate Metrics",
+				fmt.Sprintf("The TSUGA_VALIDATE_METRICS environment variable must be \"true\" or \"false\", got %q.", v),
+			)
+		}
+		validateMetrics = b
+	}
+	if !config.ValidateMetrics.IsNull() {
+		validateMetrics = config.ValidateMetrics.ValueBool()
+	}
+
+	consistencyTimeout := defaultConsistencyTimeout
+	consistencyTimeoutValue := os.Getenv("TSUGA_CONSISTENCY_TIMEOUT")
+	if !config.ConsistencyTimeout.IsNull() {
+		consistencyTimeoutValue = config.ConsistencyTimeout.ValueString()
+	}
+	if consistencyTimeoutValue != "" {
+		d, err := time.ParseDuration(consistencyTimeoutValue)
+		if err != nil || d < 0 {
+			resp.Diagnostics.AddError(
+				"Invalid Consistency Timeout",
+				fmt.Sprintf("The consistency timeout must be a non-negative duration such as \"30s\" or \"5m\", got %q.", consistencyTimeoutValue),
+			)
+		}
+		consistencyTimeout = d
+	}
+
 	if baseURL == "" {
 		resp.Diagnostics.AddError(
 			"Missing API Base URL",
@@ -102,10 +161,15 @@ func (p *tsugaProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		Version: p.version,
 		Commit:  p.commit,
 		Date:    p.date,
+
+		ConsistencyTimeout: consistencyTimeout,
+		ClusterID:          clusterID,
+		ValidateMetrics:    validateMetrics,
 	}
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
 }
 
 func (p *tsugaProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -115,7 +179,30 @@ func (p *tsugaProvider) Metadata(_ context.Context, _ provider.MetadataRequest,
 func (p *tsugaProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewTeamDataSource,
+		NewClustersDataSource,
+		NewServicesDataSource,
+		NewServiceDataSource,
+		NewMetricsDataSource,
+		NewMetricDataSource,
+		NewInventoryResourcesDataSource,
+		NewCloudAccountsDataSource,
+		NewQueryValueDataSource,
+		NewMonitorBacktestDataSource,
+		NewLogPatternsDataSource,
+		NewKubernetesClustersDataSource,
+		NewKubernetesNamespacesDataSource,
+		NewKubernetesWorkloadsDataSource,
+		NewKubernetesPodsDataSource,
+		NewQualityReportDataSource,
 		NewUserDataSource,
+		NewGrokParseDataSource,
+		NewRouteSimulationDataSource,
+	}
+}
+
+func (p *tsugaProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewIngestionApiKeyEphemeralResource,
 	}
 }
 
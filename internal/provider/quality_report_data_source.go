@@ -0,0 +1,247 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"terraform-provider-tsuga/internal/datasource_quality_report"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*qualityReportDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*qualityReportDataSource)(nil)
+
+func NewQualityReportDataSource() datasource.DataSource {
+	return &qualityReportDataSource{}
+}
+
+type qualityReportDataSource struct {
+	client *TsugaClient
+}
+
+func (d *qualityReportDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*TsugaClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *TsugaClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *qualityReportDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_quality_report"
+}
+
+func (d *qualityReportDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_quality_report.QualityReportDataSourceSchema(ctx)
+}
+
+func (d *qualityReportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config datasource_quality_report.QualityReportModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := url.Values{}
+	if !config.ClusterId.IsNull() {
+		query.Set("clusterId", config.ClusterId.ValueString())
+	}
+	if !config.Team.IsNull() {
+		query.Set("team", config.Team.ValueString())
+	}
+	apiPath := "/v1/quality-reports/latest"
+	if len(query) > 0 {
+		apiPath += "?" + query.Encode()
+	}
+
+	var apiResp qualityReportAPIResponse
+	resp.Diagnostics.Append(d.client.fetchJSON(ctx, http.MethodGet, apiPath, nil, "read quality report", &apiResp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rows := make([]datasource_quality_report.RowModel, 0, len(apiResp.Data))
+	for _, r := range apiResp.Data {
+		rows = append(rows, datasource_quality_report.RowModel{
+			Id:             types.StringValue(r.ID),
+			ReportId:       types.StringValue(r.ReportID),
+			RuleId:         types.StringValue(r.RuleID),
+			Owner:          stringValueOrNull(r.Owner),
+			Status:         types.StringValue(r.Status),
+			Score:          types.Float64Value(r.Score),
+			Weight:         types.Float64Value(r.Weight),
+			Recommendation: stringValueOrNull(r.Recommendation),
+			CreatedAt:      types.StringValue(r.CreatedAt),
+		})
+	}
+
+	reports, diags := flattenQualityReports(ctx, apiResp.Data)
+	resp.Diagnostics.Append(diags...)
+
+	violations := checkQualityReport(apiResp.Data, config.MinimumScore, config.RuleAssertions)
+	messages := make([]string, 0, len(violations))
+	for _, v := range violations {
+		messages = append(messages, v.message)
+		if config.AssertionSeverity.ValueString() == "warning" {
+			resp.Diagnostics.AddAttributeWarning(v.path, "Telemetry Quality Below Threshold", v.message)
+		} else {
+			resp.Diagnostics.AddAttributeError(v.path, "Telemetry Quality Below Threshold", v.message)
+		}
+	}
+
+	config.Rows, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: datasource_quality_report.RowAttrTypes()}, rows)
+	resp.Diagnostics.Append(diags...)
+	config.Reports, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: datasource_quality_report.ReportAttrTypes()}, reports)
+	resp.Diagnostics.Append(diags...)
+	config.Violations, diags = types.ListValueFrom(ctx, types.StringType, messages)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// flattenQualityReports groups the rows of a quality report by report, in the
+// order of their first row.
+func flattenQualityReports(ctx context.Context, rows []qualityReportRowAPIData) ([]datasource_quality_report.ReportModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var order []string
+	failed := map[string][]string{}
+	first := map[string]qualityReportRowAPIData{}
+	for _, r := range rows {
+		if _, ok := first[r.ReportID]; !ok {
+			first[r.ReportID] = r
+			order = append(order, r.ReportID)
+			failed[r.ReportID] = []string{}
+		}
+		if r.Status == "failed" {
+			failed[r.ReportID] = append(failed[r.ReportID], r.RuleID)
+		}
+	}
+
+	reports := make([]datasource_quality_report.ReportModel, 0, len(order))
+	for _, id := range order {
+		r := first[id]
+		failedRules, d := types.ListValueFrom(ctx, types.StringType, failed[id])
+		diags.Append(d...)
+		reports = append(reports, datasource_quality_report.ReportModel{
+			ReportId:     types.StringValue(id),
+			Owner:        stringValueOrNull(r.Owner),
+			OverallScore: types.Float64Value(r.ReportOverallScore),
+			TotalWeight:  types.Float64Value(r.ReportTotalWeight),
+			FailedRules:  failedRules,
+		})
+	}
+	return reports, diags
+}
+
+// qualityViolation is an unmet assertion of a quality report, at the attribute
+// that asserted it.
+type qualityViolation struct {
+	path    path.Path
+	message string
+}
+
+// checkQualityReport returns the reports of rows whose overall score is below
+// minimumScore, and the rows that do not satisfy assertions, in the order of the
+// rows. Ignored rows satisfy every assertion. An assertion whose rule has no row,
+// usually a mistyped rule ID, is a violation too.
+func checkQualityReport(rows []qualityReportRowAPIData, minimumScore types.Float64, assertions []datasource_quality_report.RuleAssertionModel) []qualityViolation {
+	var violations []qualityViolation
+
+	if !minimumScore.IsNull() {
+		seen := map[string]bool{}
+		for _, r := range rows {
+			if seen[r.ReportID] {
+				continue
+			}
+			seen[r.ReportID] = true
+			if r.ReportOverallScore < minimumScore.ValueFloat64() {
+				violations = append(violations, qualityViolation{
+					path:    path.Root("minimum_score"),
+					message: fmt.Sprintf("The quality score of %s is %g, below the minimum of %g.", qualityReportScope(r), r.ReportOverallScore, minimumScore.ValueFloat64()),
+				})
+			}
+		}
+	}
+
+	for i, a := range assertions {
+		matched := false
+		for _, r := range rows {
+			if r.RuleID != a.RuleId.ValueString() {
+				continue
+			}
+			matched = true
+			if r.Status == "ignored" {
+				continue
+			}
+			var message string
+			switch {
+			case !a.MinimumScore.IsNull() && r.Score < a.MinimumScore.ValueFloat64():
+				message = fmt.Sprintf("Rule %s scores %g for %s, below the minimum of %g.", r.RuleID, r.Score, qualityReportScope(r), a.MinimumScore.ValueFloat64())
+			case a.MinimumScore.IsNull() && r.Status == "failed":
+				message = fmt.Sprintf("Rule %s failed for %s.", r.RuleID, qualityReportScope(r))
+			default:
+				continue
+			}
+			if r.Recommendation != "" {
+				message += " " + r.Recommendation
+			}
+			violations = append(violations, qualityViolation{
+				path:    path.Root("rule_assertions").AtListIndex(i),
+				message: message,
+			})
+		}
+		if !matched {
+			violations = append(violations, qualityViolation{
+				path:    path.Root("rule_assertions").AtListIndex(i).AtName("rule_id"),
+				message: fmt.Sprintf("Rule %s is not in the report. Check the rule ID against the rule_id of rows.", a.RuleId.ValueString()),
+			})
+		}
+	}
+	return violations
+}
+
+// qualityReportScope describes what the report of a row applies to.
+func qualityReportScope(r qualityReportRowAPIData) string {
+	if r.Owner == "" {
+		return "the cluster"
+	}
+	return "team " + r.Owner
+}
+
+type qualityReportRowAPIData struct {
+	ID                 string  `json:"id"`
+	ReportID           string  `json:"reportId"`
+	RuleID             string  `json:"ruleId"`
+	Owner              string  `json:"owner"`
+	Status             string  `json:"status"`
+	Score              float64 `json:"score"`
+	Weight             float64 `json:"weight"`
+	ReportOverallScore float64 `json:"reportOverallScore"`
+	ReportTotalWeight  float64 `json:"reportTotalWeight"`
+	Recommendation     string  `json:"recommendation"`
+	CreatedAt          string  `json:"createdAt"`
+}
+
+type qualityReportAPIResponse struct {
+	Data []qualityReportRowAPIData `json:"data"`
+}
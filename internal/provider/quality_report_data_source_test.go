@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccQualityReportDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "tsuga_quality_report" "latest" {
+  minimum_score      = 0.1
+  assertion_severity = "warning"
+  rule_assertions = [
+    { rule_id = "service-name-present" },
+    { rule_id = "team-has-route", minimum_score = 0.5 },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.tsuga_quality_report.latest", "rows.#"),
+					resource.TestCheckResourceAttrSet("data.tsuga_quality_report.latest", "reports.#"),
+					resource.TestCheckResourceAttrSet("data.tsuga_quality_report.latest", "violations.#"),
+				),
+			},
+		},
+	})
+}
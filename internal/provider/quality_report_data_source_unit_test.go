@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"terraform-provider-tsuga/internal/datasource_quality_report"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var qualityReportRows = []qualityReportRowAPIData{
+	{ReportID: "r1", RuleID: "team-has-route", Owner: "t1", Status: "passed", Score: 1, ReportOverallScore: 0.6},
+	{ReportID: "r1", RuleID: "service-name-present", Owner: "t1", Status: "failed", Score: 0.4, ReportOverallScore: 0.6, Recommendation: "Set service.name."},
+	{ReportID: "r2", RuleID: "service-name-present", Status: "passed", Score: 0.9, ReportOverallScore: 0.9},
+	{ReportID: "r2", RuleID: "no-orphan-spans", Status: "ignored", Score: 0, ReportOverallScore: 0.9},
+}
+
+func TestCheckQualityReport(t *testing.T) {
+	cases := []struct {
+		name         string
+		minimumScore types.Float64
+		assertions   []datasource_quality_report.RuleAssertionModel
+		want         []qualityViolation
+	}{
+		{
+			name:         "minimum score",
+			minimumScore: types.Float64Value(0.7),
+			want: []qualityViolation{
+				{path.Root("minimum_score"), "The quality score of team t1 is 0.6, below the minimum of 0.7."},
+			},
+		},
+		{
+			name:         "rule must not fail",
+			minimumScore: types.Float64Null(),
+			assertions: []datasource_quality_report.RuleAssertionModel{
+				{RuleId: types.StringValue("team-has-route"), MinimumScore: types.Float64Null()},
+				{RuleId: types.StringValue("service-name-present"), MinimumScore: types.Float64Null()},
+			},
+			want: []qualityViolation{
+				{path.Root("rule_assertions").AtListIndex(1), "Rule service-name-present failed for team t1. Set service.name."},
+			},
+		},
+		{
+			name:         "rule minimum score",
+			minimumScore: types.Float64Null(),
+			assertions: []datasource_quality_report.RuleAssertionModel{
+				{RuleId: types.StringValue("service-name-present"), MinimumScore: types.Float64Value(0.95)},
+				{RuleId: types.StringValue("no-orphan-spans"), MinimumScore: types.Float64Value(1)},
+			},
+			want: []qualityViolation{
+				{path.Root("rule_assertions").AtListIndex(0), "Rule service-name-present scores 0.4 for team t1, below the minimum of 0.95. Set service.name."},
+				{path.Root("rule_assertions").AtListIndex(0), "Rule service-name-present scores 0.9 for the cluster, below the minimum of 0.95."},
+			},
+		},
+		{
+			name:         "unknown rule",
+			minimumScore: types.Float64Null(),
+			assertions: []datasource_quality_report.RuleAssertionModel{
+				{RuleId: types.StringValue("team-has-route"), MinimumScore: types.Float64Null()},
+				{RuleId: types.StringValue("team-has-rout"), MinimumScore: types.Float64Null()},
+			},
+			want: []qualityViolation{
+				{path.Root("rule_assertions").AtListIndex(1).AtName("rule_id"), "Rule team-has-rout is not in the report. Check the rule ID against the rule_id of rows."},
+			},
+		},
+		{
+			name:         "satisfied",
+			minimumScore: types.Float64Value(0.5),
+			assertions: []datasource_quality_report.RuleAssertionModel{
+				{RuleId: types.StringValue("team-has-route"), MinimumScore: types.Float64Value(1)},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := checkQualityReport(qualityReportRows, tc.minimumScore, tc.assertions)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("checkQualityReport() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFlattenQualityReports(t *testing.T) {
+	got, diags := flattenQualityReports(context.Background(), qualityReportRows)
+	if diags.HasError() {
+		t.Fatalf("flattenQualityReports() diagnostics = %v", diags)
+	}
+	if len(got) != 2 {
+		t.Fatalf("flattenQualityReports() = %+v, want 2 reports", got)
+	}
+	failed, _ := types.ListValueFrom(context.Background(), types.StringType, []string{"service-name-present"})
+	if got[0].Owner.ValueString() != "t1" || got[0].OverallScore.ValueFloat64() != 0.6 || !got[0].FailedRules.Equal(failed) {
+		t.Errorf("flattenQualityReports()[0] = %+v, want the report of team t1 with one failed rule", got[0])
+	}
+	if !got[1].Owner.IsNull() || len(got[1].FailedRules.Elements()) != 0 {
+		t.Errorf("flattenQualityReports()[1] = %+v, want the cluster report without failed rules", got[1])
+	}
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"terraform-provider-tsuga/internal/filterexpression"
+	"terraform-provider-tsuga/internal/query"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// validateQuerySyntax parses a filter or query string with the Tsuga query parser and
+// reports syntax errors on the attribute at attrPath, which uses the same
+// `a.b[0].c` notation as the other ValidateConfig messages. Null and unknown values are
+// skipped.
+func validateQuerySyntax(value types.String, attrPath string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if value.IsNull() || value.IsUnknown() {
+		return diags
+	}
+
+	src := value.ValueString()
+	_, err := query.Parse(src)
+	var syntaxErr *query.SyntaxError
+	if !errors.As(err, &syntaxErr) {
+		return diags
+	}
+
+	line := strings.Split(src, "\n")[syntaxErr.Line-1]
+	diags.AddAttributeError(
+		attributePath(attrPath),
+		"Invalid query syntax",
+		fmt.Sprintf("%s: %s\n\n    %s\n    %s^", attrPath, syntaxErr, line, strings.Repeat(" ", syntaxErr.Column-1)),
+	)
+	return diags
+}
+
+// validateFilterExpression checks a filter_expression object at attrPath. Expressions
+// that are not fully known yet are skipped.
+func validateFilterExpression(ctx context.Context, expr types.Object, attrPath string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if expr.IsNull() || expr.IsUnknown() {
+		return diags
+	}
+
+	_, err := filterexpression.Compile(ctx, expr)
+	var exprErr *filterexpression.Error
+	switch {
+	case err == nil, errors.Is(err, filterexpression.ErrUnknown):
+	case errors.As(err, &exprErr):
+		diags.AddAttributeError(
+			attributePath(attrPath+exprErr.Path),
+			"Invalid filter expression",
+			fmt.Sprintf("%s%s: %s", attrPath, exprErr.Path, exprErr.Msg),
+		)
+	default:
+		diags.AddError("Invalid filter expression", fmt.Sprintf("%s: %s", attrPath, err))
+	}
+	return diags
+}
+
+// resolveQuery returns the query string sent to the API for a query attribute and its
+// filter_expression alternative: the compiled expression when it is set, the string
+// otherwise.
+func resolveQuery(ctx context.Context, value types.String, expr types.Object) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if expr.IsNull() || expr.IsUnknown() {
+		return value.ValueString(), diags
+	}
+	compiled, err := filterexpression.Compile(ctx, expr)
+	if err != nil {
+		diags.AddError("Invalid filter expression", fmt.Sprintf("Unable to compile filter expression: %s", err))
+	}
+	return compiled, diags
+}
+
+// preserveFilterExpressions restores the filter_expression attributes of prior in a
+// state built from an API response, see filterexpression.Preserve. Create and Update
+// pass the plan as prior, Read the previous state.
+func preserveFilterExpressions(prior tftypes.Value, state *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+	raw, err := filterexpression.Preserve(prior, state.Raw)
+	if err != nil {
+		diags.AddError("Internal Error", fmt.Sprintf("Unable to preserve filter expressions: %s", err))
+		return diags
+	}
+	state.Raw = raw
+	return diags
+}
+
+// attributePath converts a `configuration.log.queries[0].filter` style path into a
+// framework path, so diagnostics point at the attribute in the configuration.
+func attributePath(s string) path.Path {
+	var p path.Path
+	for i, segment := range strings.Split(s, ".") {
+		name, rest, _ := strings.Cut(segment, "[")
+		if i == 0 {
+			p = path.Root(name)
+		} else {
+			p = p.AtName(name)
+		}
+		for rest != "" {
+			var index string
+			index, rest, _ = strings.Cut(rest, "]")
+			if n, err := strconv.Atoi(index); err == nil {
+				p = p.AtListIndex(n)
+			}
+			rest = strings.TrimPrefix(rest, "[")
+		}
+	}
+	return p
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateQuerySyntax(t *testing.T) {
+	for _, q := range []string{"", "*", "service:api AND (status_code:>=500 OR level:error)", "env:prod -service:web"} {
+		if diags := validateQuerySyntax(types.StringValue(q), "query"); diags.HasError() {
+			t.Errorf("unexpected diagnostics for %q: %v", q, diags)
+		}
+	}
+	if diags := validateQuerySyntax(types.StringUnknown(), "query"); diags.HasError() {
+		t.Errorf("unexpected diagnostics for unknown value: %v", diags)
+	}
+
+	diags := validateQuerySyntax(types.StringValue("service:api AND (env:prod"), "configuration.log.queries[1].filter")
+	if len(diags) != 1 {
+		t.Fatalf("expected one diagnostic, got %v", diags)
+	}
+	detail := diags[0].Detail()
+	if !strings.HasPrefix(detail, `configuration.log.queries[1].filter: column 17: unclosed "("`) {
+		t.Errorf("unexpected detail: %s", detail)
+	}
+	if !strings.HasSuffix(detail, "service:api AND (env:prod\n                    ^") {
+		t.Errorf("expected a caret under the error column, got:\n%s", detail)
+	}
+
+	withPath, ok := diags[0].(interface{ Path() path.Path })
+	if !ok {
+		t.Fatalf("expected an attribute diagnostic, got %T", diags[0])
+	}
+	want := path.Root("configuration").AtName("log").AtName("queries").AtListIndex(1).AtName("filter")
+	if !withPath.Path().Equal(want) {
+		t.Errorf("diagnostic path = %s, want %s", withPath.Path(), want)
+	}
+}
+
+func TestAttributePath(t *testing.T) {
+	got := attributePath("processors[2].split.items[0].processors[1].creator.category.clauses[3].query")
+	want := path.Root("processors").AtListIndex(2).AtName("split").AtName("items").AtListIndex(0).
+		AtName("processors").AtListIndex(1).AtName("creator").AtName("category").AtName("clauses").AtListIndex(3).AtName("query")
+	if !got.Equal(want) {
+		t.Errorf("attributePath() = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,306 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"terraform-provider-tsuga/internal/datasource_query_value"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*queryValueDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*queryValueDataSource)(nil)
+var _ datasource.DataSourceWithConfigValidators = (*queryValueDataSource)(nil)
+var _ datasource.DataSourceWithValidateConfig = (*queryValueDataSource)(nil)
+
+func NewQueryValueDataSource() datasource.DataSource {
+	return &queryValueDataSource{}
+}
+
+type queryValueDataSource struct {
+	client *TsugaClient
+}
+
+func (d *queryValueDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*TsugaClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *TsugaClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *queryValueDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_query_value"
+}
+
+func (d *queryValueDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_query_value.QueryValueDataSourceSchema(ctx)
+}
+
+func (d *queryValueDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("queries"),
+			path.MatchRoot("promql"),
+		),
+		datasourcevalidator.RequiredTogether(
+			path.MatchRoot("queries"),
+			path.MatchRoot("source"),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("promql"),
+			path.MatchRoot("group_by"),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("promql"),
+			path.MatchRoot("formula"),
+		),
+	}
+}
+
+func (d *queryValueDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config datasource_query_value.QueryValueModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	formulas := []formulaAttribute{{value: config.Formula, path: "formula"}}
+	resp.Diagnostics.Append(validateMonitorQueries(ctx, config.Queries, formulas, "queries")...)
+}
+
+func (d *queryValueDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config datasource_query_value.QueryValueModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeRange, diags := expandQueryTimeRange(config.Window, config.To)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var results []queryValueResult
+	if config.Promql != nil {
+		results, diags = d.readPromql(ctx, config, timeRange)
+	} else {
+		results, diags = d.readScalar(ctx, config, timeRange)
+	}
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	elements := make([]attr.Value, 0, len(results))
+	for _, r := range results {
+		group, diags := types.MapValueFrom(ctx, types.StringType, flattenGroupLabels(r.group))
+		resp.Diagnostics.Append(diags...)
+		elements = append(elements, types.ObjectValueMust(datasource_query_value.ResultAttrTypes(), map[string]attr.Value{
+			"id":    types.StringValue(r.id),
+			"group": group,
+			"value": types.Float64Value(r.value),
+		}))
+	}
+	config.Results, diags = types.ListValue(types.ObjectType{AttrTypes: datasource_query_value.ResultAttrTypes()}, elements)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.Value = types.Float64Null()
+	for _, r := range results {
+		// With a formula, the API returns the results of the queries along with
+		// those of the formula, which is the value asked for.
+		if config.Formula.IsNull() || r.id == "formula" {
+			config.Value = types.Float64Value(r.value)
+			break
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func (d *queryValueDataSource) readScalar(ctx context.Context, config datasource_query_value.QueryValueModel, timeRange queryAPITimeRange) ([]queryValueResult, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	queries, qDiags := expandMonitorQueries(ctx, config.Queries)
+	diags.Append(qDiags...)
+	groupBy, gDiags := expandAggregationGroupBy(ctx, config.GroupBy)
+	diags.Append(gDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	requestBody := map[string]interface{}{
+		"timeRange":  timeRange,
+		"dataSource": config.Source.ValueString(),
+		"queries":    queries,
+	}
+	if len(groupBy) > 0 {
+		requestBody["groupBy"] = groupBy
+	}
+	if !config.Formula.IsNull() {
+		requestBody["formula"] = config.Formula.ValueString()
+	}
+
+	var apiResp scalarAggregationAPIResponse
+	diags.Append(d.client.fetchJSON(ctx, http.MethodPost, clusterPath("/v1/aggregation/multi-query/scalar", config.ClusterId), requestBody, "run scalar query", &apiResp)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	results := make([]queryValueResult, 0, len(apiResp.Data.Results))
+	for _, r := range apiResp.Data.Results {
+		results = append(results, queryValueResult{id: r.ID, group: r.Group, value: r.Value})
+	}
+	return results, diags
+}
+
+// readPromql runs the PromQL query and returns the last point of each series.
+// Series without points in the time range have no result.
+func (d *queryValueDataSource) readPromql(ctx context.Context, config datasource_query_value.QueryValueModel, timeRange queryAPITimeRange) ([]queryValueResult, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	step := config.Promql.Step.ValueString()
+	if config.Promql.Step.IsNull() {
+		step = strconv.FormatInt(timeRange.To-timeRange.From, 10) + "s"
+	}
+	requestBody := map[string]interface{}{
+		"query":     config.Promql.Query.ValueString(),
+		"step":      step,
+		"timeRange": timeRange,
+	}
+
+	var apiResp timeseriesAggregationAPIResponse
+	diags.Append(d.client.fetchJSON(ctx, http.MethodPost, clusterPath("/v1/promql", config.ClusterId), requestBody, "run PromQL query", &apiResp)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	results := make([]queryValueResult, 0, len(apiResp.Data.Series))
+	for _, s := range apiResp.Data.Series {
+		if len(s.Points) == 0 {
+			continue
+		}
+		last := s.Points[0]
+		for _, p := range s.Points[1:] {
+			if p.Timestamp > last.Timestamp {
+				last = p
+			}
+		}
+		results = append(results, queryValueResult{id: s.ID, group: s.Group, value: last.Value})
+	}
+	return results, diags
+}
+
+// expandQueryTimeRange returns the time range of length window ending at to, or
+// at the time of the call when to is null.
+func expandQueryTimeRange(window, to types.String) (queryAPITimeRange, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	d, err := parseDuration(window.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("window"), "Invalid Duration", err.Error())
+		return queryAPITimeRange{}, diags
+	}
+
+	end := time.Now()
+	if !to.IsNull() {
+		end, err = time.Parse(time.RFC3339, to.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("to"), "Invalid Timestamp", fmt.Sprintf("to must be an RFC 3339 timestamp such as 2024-06-01T00:00:00Z: %s", err))
+			return queryAPITimeRange{}, diags
+		}
+	}
+
+	return queryAPITimeRange{From: end.Add(-d).Unix(), To: end.Unix()}, diags
+}
+
+// clusterPath adds the clusterId query parameter to an API path when clusterID is
+// set. Otherwise the provider's cluster is used.
+func clusterPath(apiPath string, clusterID types.String) string {
+	if clusterID.IsNull() {
+		return apiPath
+	}
+	return apiPath + "?" + url.Values{"clusterId": {clusterID.ValueString()}}.Encode()
+}
+
+// flattenGroupLabels formats the group of an aggregation result, whose values are
+// strings or numbers, as strings.
+func flattenGroupLabels(group map[string]interface{}) map[string]string {
+	labels := make(map[string]string, len(group))
+	for k, v := range group {
+		switch v := v.(type) {
+		case string:
+			labels[k] = v
+		case float64:
+			labels[k] = strconv.FormatFloat(v, 'f', -1, 64)
+		case nil:
+			labels[k] = ""
+		default:
+			labels[k] = fmt.Sprint(v)
+		}
+	}
+	return labels
+}
+
+type queryValueResult struct {
+	id    string
+	group map[string]interface{}
+	value float64
+}
+
+type queryAPITimeRange struct {
+	From int64 `json:"from"`
+	To   int64 `json:"to"`
+}
+
+type scalarAggregationAPIResponse struct {
+	Data struct {
+		Results []struct {
+			ID    string                 `json:"id"`
+			Group map[string]interface{} `json:"group"`
+			Value float64                `json:"value"`
+		} `json:"results"`
+	} `json:"data"`
+}
+
+type timeseriesAggregationAPIResponse struct {
+	Data struct {
+		Series []timeseriesAPISeries `json:"series"`
+	} `json:"data"`
+}
+
+type timeseriesAPISeries struct {
+	ID     string                 `json:"id"`
+	Group  map[string]interface{} `json:"group"`
+	Points []timeseriesAPIPoint   `json:"points"`
+}
+
+type timeseriesAPIPoint struct {
+	Timestamp int64   `json:"timestamp"`
+	Value     float64 `json:"value"`
+}
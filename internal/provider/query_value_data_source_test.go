@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccQueryValueDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "tsuga_query_value" "errors" {
+  window = "1d"
+  source = "logs"
+  queries = [
+    {
+      filter    = "level:error"
+      aggregate = { count = {} }
+    },
+    {
+      filter    = "*"
+      aggregate = { count = {} }
+    },
+  ]
+  formula = "q1 / q2 * 100"
+}
+
+data "tsuga_query_value" "by_service" {
+  window = "1d"
+  to     = "2024-06-01T00:00:00Z"
+  source = "logs"
+  queries = [
+    {
+      filter    = "*"
+      aggregate = { count = {} }
+    },
+  ]
+  group_by = [
+    {
+      fields = ["service"]
+      limit  = 5
+    },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.tsuga_query_value.errors", "results.#"),
+					resource.TestCheckResourceAttrSet("data.tsuga_query_value.by_service", "results.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQueryValueDataSource_InvalidFormula(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "tsuga_query_value" "test" {
+  window = "1h"
+  source = "logs"
+  queries = [
+    {
+      filter    = "*"
+      aggregate = { count = {} }
+    },
+  ]
+  formula = "q1 / q2"
+}
+`,
+				ExpectError: regexp.MustCompile(`q2 does not exist`),
+			},
+		},
+	})
+}
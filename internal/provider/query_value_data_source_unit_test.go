@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"terraform-provider-tsuga/internal/datasource_query_value"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestExpandQueryTimeRange(t *testing.T) {
+	got, diags := expandQueryTimeRange(types.StringValue("14d"), types.StringValue("2024-06-01T00:00:00Z"))
+	if diags.HasError() {
+		t.Fatalf("expandQueryTimeRange() diagnostics = %v", diags)
+	}
+	want := queryAPITimeRange{From: 1715990400, To: 1717200000}
+	if got != want {
+		t.Errorf("expandQueryTimeRange() = %+v, want %+v", got, want)
+	}
+
+	if _, diags := expandQueryTimeRange(types.StringValue("1h"), types.StringValue("yesterday")); !diags.HasError() {
+		t.Error("expandQueryTimeRange() with an invalid to: expected an error")
+	}
+}
+
+func TestQueryValueReadPromql(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if r.URL.Path != "/v1/promql" || r.URL.Query().Get("clusterId") != "c2" || body["step"] != "3600s" {
+			t.Errorf("unexpected request to %s with %v", r.URL, body)
+		}
+		_, _ = w.Write([]byte(`{"data":{"series":[
+			{"id":"a","group":{"service":"api","code":200},"points":[{"timestamp":20,"value":2},{"timestamp":10,"value":1}]},
+			{"id":"b","group":{},"points":[]}
+		]}}`))
+	}))
+	defer server.Close()
+	d := &queryValueDataSource{client: &TsugaClient{BaseURL: server.URL, ClusterID: "c1", client: server.Client()}}
+
+	config := datasource_query_value.QueryValueModel{
+		ClusterId: types.StringValue("c2"),
+		Promql:    &datasource_query_value.PromqlModel{Query: types.StringValue("up"), Step: types.StringNull()},
+	}
+	results, diags := d.readPromql(context.Background(), config, queryAPITimeRange{From: 1717196400, To: 1717200000})
+	if diags.HasError() {
+		t.Fatalf("readPromql() diagnostics = %v", diags)
+	}
+	if len(results) != 1 || results[0].id != "a" || results[0].value != 2 {
+		t.Fatalf("readPromql() = %+v, want the last point of series a", results)
+	}
+	if got, want := flattenGroupLabels(results[0].group), map[string]string{"service": "api", "code": "200"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("flattenGroupLabels() = %v, want %v", got, want)
+	}
+}
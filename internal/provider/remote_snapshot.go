@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// remoteSnapshotKey is the private state key holding the API object as last read
+// or written by Terraform. It fingerprints the object: Update compares it with the
+// live object and refuses to overwrite changes made elsewhere, such as in the Tsuga
+// UI, between plan and apply. The API exposes no version, update time or ETag.
+const remoteSnapshotKey = "remote_snapshot"
+
+// maxRemoteChanges caps the changed fields listed by checkRemoteUnchanged.
+const maxRemoteChanges = 20
+
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// setRemoteSnapshot records data, the API object decoded into the provider's
+// response type, as the fingerprint of the resource. Decoding first keeps fields
+// the provider does not model, which may change on their own, out of it.
+func setRemoteSnapshot(ctx context.Context, private privateStateSetter, data any) diag.Diagnostics {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Internal Error", fmt.Sprintf("Unable to encode remote object snapshot: %s", err))
+		return diags
+	}
+	return private.SetKey(ctx, remoteSnapshotKey, raw)
+}
+
+// checkRemoteUnchanged fails when current, the live API object, differs from the
+// snapshot recorded when the plan was made. State written before snapshots were
+// recorded has none, and is not checked.
+func checkRemoteUnchanged(ctx context.Context, private privateStateGetter, kind, id string, current any) diag.Diagnostics {
+	raw, diags := private.GetKey(ctx, remoteSnapshotKey)
+	if diags.HasError() || raw == nil {
+		return diags
+	}
+
+	var before, after any
+	if err := json.Unmarshal(raw, &before); err != nil {
+		diags.AddError("Internal Error", fmt.Sprintf("Unable to decode remote object snapshot: %s", err))
+		return diags
+	}
+	encoded, err := json.Marshal(current)
+	if err == nil {
+		err = json.Unmarshal(encoded, &after)
+	}
+	if err != nil {
+		diags.AddError("Internal Error", fmt.Sprintf("Unable to encode remote object: %s", err))
+		return diags
+	}
+
+	changes := remoteChanges(before, after)
+	if len(changes) == 0 {
+		return diags
+	}
+	if len(changes) > maxRemoteChanges {
+		changes = append(changes[:maxRemoteChanges], fmt.Sprintf("... and %d more", len(changes)-maxRemoteChanges))
+	}
+
+	diags.AddError(
+		"Conflicting Remote Changes",
+		fmt.Sprintf("The %s %s was changed outside Terraform after the plan was made, and applying it would overwrite these changes:\n\n  %s\n\n"+
+			"Run terraform plan again to review them against the configuration.", kind, id, strings.Join(changes, "\n  ")),
+	)
+	return diags
+}
+
+// remoteChanges lists the leaves of two decoded JSON documents that differ, as
+// `path: before -> after` lines sorted by path.
+func remoteChanges(before, after any) []string {
+	var changes []string
+	collectRemoteChanges("", before, after, &changes)
+	sort.Strings(changes)
+	return changes
+}
+
+func collectRemoteChanges(path string, before, after any, changes *[]string) {
+	beforeMap, beforeIsMap := before.(map[string]any)
+	afterMap, afterIsMap := after.(map[string]any)
+	if beforeIsMap && afterIsMap {
+		keys := map[string]struct{}{}
+		for k := range beforeMap {
+			keys[k] = struct{}{}
+		}
+		for k := range afterMap {
+			keys[k] = struct{}{}
+		}
+		for k := range keys {
+			child := k
+			if path != "" {
+				child = path + "." + k
+			}
+			collectRemoteChanges(child, beforeMap[k], afterMap[k], changes)
+		}
+		return
+	}
+
+	beforeList, beforeIsList := before.([]any)
+	afterList, afterIsList := after.([]any)
+	if beforeIsList && afterIsList {
+		for i := 0; i < len(beforeList) || i < len(afterList); i++ {
+			var b, a any
+			if i < len(beforeList) {
+				b = beforeList[i]
+			}
+			if i < len(afterList) {
+				a = afterList[i]
+			}
+			collectRemoteChanges(fmt.Sprintf("%s[%d]", path, i), b, a, changes)
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(before, after) {
+		*changes = append(*changes, fmt.Sprintf("%s: %s -> %s", path, remoteValue(before), remoteValue(after)))
+	}
+}
+
+// remoteValue renders a changed leaf, shortened so one field cannot flood the
+// diagnostic.
+func remoteValue(v any) string {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	s := string(raw)
+	if len(s) > 80 {
+		s = s[:77] + "..."
+	}
+	return s
+}
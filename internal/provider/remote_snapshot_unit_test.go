@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+type testPrivateState map[string][]byte
+
+func (p testPrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p testPrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	p[key] = value
+	return nil
+}
+
+func TestRemoteChanges(t *testing.T) {
+	before := map[string]any{
+		"name": "latency",
+		"tags": []any{map[string]any{"key": "env", "value": "prod"}},
+		"configuration": map[string]any{
+			"conditions": []any{map[string]any{"threshold": 10.0}},
+		},
+	}
+	after := map[string]any{
+		"name": "latency",
+		"tags": []any{
+			map[string]any{"key": "env", "value": "staging"},
+			map[string]any{"key": "team", "value": "sre"},
+		},
+		"configuration": map[string]any{
+			"conditions": []any{map[string]any{"threshold": 20.0}},
+			"timeWindow": "5m",
+		},
+	}
+
+	want := []string{
+		`configuration.conditions[0].threshold: 10 -> 20`,
+		`configuration.timeWindow: null -> "5m"`,
+		`tags[0].value: "prod" -> "staging"`,
+		`tags[1]: null -> {"key":"team","value":"sre"}`,
+	}
+	if got := remoteChanges(before, after); !reflect.DeepEqual(got, want) {
+		t.Errorf("remoteChanges() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	if got := remoteChanges(before, before); len(got) != 0 {
+		t.Errorf("remoteChanges() of identical documents = %v, want none", got)
+	}
+}
+
+func TestCheckRemoteUnchanged(t *testing.T) {
+	ctx := context.Background()
+	type object struct {
+		Name     string `json:"name"`
+		Priority int    `json:"priority"`
+	}
+
+	private := testPrivateState{}
+	if diags := checkRemoteUnchanged(ctx, private, "monitor", "m1", object{Name: "a"}); diags.HasError() {
+		t.Fatalf("state without snapshot: unexpected diagnostics: %v", diags)
+	}
+
+	if diags := setRemoteSnapshot(ctx, private, object{Name: "a", Priority: 2}); diags.HasError() {
+		t.Fatalf("setRemoteSnapshot() diagnostics: %v", diags)
+	}
+	if diags := checkRemoteUnchanged(ctx, private, "monitor", "m1", object{Name: "a", Priority: 2}); diags.HasError() {
+		t.Fatalf("unchanged object: unexpected diagnostics: %v", diags)
+	}
+
+	diags := checkRemoteUnchanged(ctx, private, "monitor", "m1", object{Name: "a", Priority: 4})
+	if !diags.HasError() {
+		t.Fatal("changed object: expected an error")
+	}
+	if detail := diags[0].Detail(); !strings.Contains(detail, "monitor m1") || !strings.Contains(detail, "priority: 2 -> 4") {
+		t.Errorf("unexpected detail: %s", detail)
+	}
+}
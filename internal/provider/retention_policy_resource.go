@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"terraform-provider-tsuga/internal/resource_retention_policy"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -25,6 +26,14 @@ type retentionPolicyResource struct {
 	client *TsugaClient
 }
 
+// retentionPolicyResourceModel adds `on_destroy` and the `timeouts` block to the
+// generated retention policy model.
+type retentionPolicyResourceModel struct {
+	resource_retention_policy.RetentionPolicyModel
+	OnDestroy types.String   `tfsdk:"on_destroy"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (r *retentionPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -47,7 +56,7 @@ func (r *retentionPolicyResource) Metadata(_ context.Context, req resource.Metad
 }
 
 func (r *retentionPolicyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = resource_retention_policy.RetentionPolicyResourceSchema(ctx)
+	resp.Schema = withTimeouts(ctx, withOnDestroy(withStableID(resource_retention_policy.RetentionPolicyResourceSchema(ctx)), "is_enabled"))
 }
 
 func (r *retentionPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -55,7 +64,7 @@ func (r *retentionPolicyResource) ImportState(ctx context.Context, req resource.
 }
 
 func (r *retentionPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan resource_retention_policy.RetentionPolicyModel
+	var plan retentionPolicyResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
@@ -63,19 +72,16 @@ func (r *retentionPolicyResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	requestBody := map[string]interface{}{
-		"dataSource":   plan.DataSource.ValueString(),
-		"durationDays": plan.DurationDays.ValueInt64(),
-		"isEnabled":    plan.IsEnabled.ValueBool(),
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !plan.Env.IsNull() && !plan.Env.IsUnknown() && plan.Env.ValueString() != "" {
-		requestBody["env"] = plan.Env.ValueString()
-	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 
-	if !plan.TeamId.IsNull() && !plan.TeamId.IsUnknown() && plan.TeamId.ValueString() != "" {
-		requestBody["teamId"] = plan.TeamId.ValueString()
-	}
+	requestBody := retentionPolicyRequestBody(plan.RetentionPolicyModel)
 
 	httpResp, err := r.client.doRequest(ctx, http.MethodPost, "/v1/retention-policies", requestBody)
 	if err != nil {
@@ -123,7 +129,7 @@ func (r *retentionPolicyResource) Create(ctx context.Context, req resource.Creat
 }
 
 func (r *retentionPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state resource_retention_policy.RetentionPolicyModel
+	var state retentionPolicyResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
@@ -131,6 +137,15 @@ func (r *retentionPolicyResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	apiPath := fmt.Sprintf("/v1/retention-policies/%s", state.Id.ValueString())
 	httpResp, err := r.client.doRequest(ctx, http.MethodGet, apiPath, nil)
 	if err != nil {
@@ -183,8 +198,8 @@ func (r *retentionPolicyResource) Read(ctx context.Context, req resource.ReadReq
 }
 
 func (r *retentionPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan resource_retention_policy.RetentionPolicyModel
-	var state resource_retention_policy.RetentionPolicyModel
+	var plan retentionPolicyResourceModel
+	var state retentionPolicyResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -193,19 +208,16 @@ func (r *retentionPolicyResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	requestBody := map[string]interface{}{
-		"dataSource":   plan.DataSource.ValueString(),
-		"durationDays": plan.DurationDays.ValueInt64(),
-		"isEnabled":    plan.IsEnabled.ValueBool(),
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !plan.Env.IsNull() && !plan.Env.IsUnknown() && plan.Env.ValueString() != "" {
-		requestBody["env"] = plan.Env.ValueString()
-	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
 
-	if !plan.TeamId.IsNull() && !plan.TeamId.IsUnknown() && plan.TeamId.ValueString() != "" {
-		requestBody["teamId"] = plan.TeamId.ValueString()
-	}
+	requestBody := retentionPolicyRequestBody(plan.RetentionPolicyModel)
 
 	apiPath := fmt.Sprintf("/v1/retention-policies/%s", state.Id.ValueString())
 	httpResp, err := r.client.doRequest(ctx, http.MethodPut, apiPath, requestBody)
@@ -254,7 +266,7 @@ func (r *retentionPolicyResource) Update(ctx context.Context, req resource.Updat
 }
 
 func (r *retentionPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state resource_retention_policy.RetentionPolicyModel
+	var state retentionPolicyResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
@@ -262,7 +274,26 @@ func (r *retentionPolicyResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	apiPath := fmt.Sprintf("/v1/retention-policies/%s", state.Id.ValueString())
+
+	switch state.OnDestroy.ValueString() {
+	case onDestroyAbandon:
+		return
+	case onDestroyDisable:
+		state.IsEnabled = types.BoolValue(false)
+		resp.Diagnostics.Append(disableOnDestroy(ctx, r.client, apiPath, retentionPolicyRequestBody(state.RetentionPolicyModel), "retention policy")...)
+		return
+	}
+
 	httpResp, err := r.client.doRequest(ctx, http.MethodDelete, apiPath, map[string]interface{}{})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete retention policy: %s", err))
@@ -278,6 +309,24 @@ func (r *retentionPolicyResource) Delete(ctx context.Context, req resource.Delet
 	}
 }
 
+func retentionPolicyRequestBody(plan resource_retention_policy.RetentionPolicyModel) map[string]interface{} {
+	requestBody := map[string]interface{}{
+		"dataSource":   plan.DataSource.ValueString(),
+		"durationDays": plan.DurationDays.ValueInt64(),
+		"isEnabled":    plan.IsEnabled.ValueBool(),
+	}
+
+	if !plan.Env.IsNull() && !plan.Env.IsUnknown() && plan.Env.ValueString() != "" {
+		requestBody["env"] = plan.Env.ValueString()
+	}
+
+	if !plan.TeamId.IsNull() && !plan.TeamId.IsUnknown() && plan.TeamId.ValueString() != "" {
+		requestBody["teamId"] = plan.TeamId.ValueString()
+	}
+
+	return requestBody
+}
+
 type retentionPolicyAPIResponse struct {
 	Data struct {
 		ID           string `json:"id"`
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccRetentionPolicyResource(t *testing.T) {
@@ -60,3 +61,67 @@ resource "tsuga_retention_policy" "test" {
 		},
 	})
 }
+
+func TestAccRetentionPolicyResource_onDestroyDisable(t *testing.T) {
+	teamName := fmt.Sprintf("test-%s", randomString(8))
+	team := fmt.Sprintf(`
+resource "tsuga_team" "test-team" {
+  name = "%s"
+  visibility = "public"
+}
+`, teamName)
+	policy := func(isEnabled bool, onDestroy string) string {
+		return fmt.Sprintf(`
+resource "tsuga_retention_policy" "test" {
+  team_id       = tsuga_team.test-team.id
+  data_source   = "logs"
+  duration_days = 30
+  is_enabled    = %t
+  %s
+}
+`, isEnabled, onDestroy)
+	}
+
+	var policyID string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + team + policy(true, `on_destroy = "disable"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("tsuga_retention_policy.test", "on_destroy", "disable"),
+					func(s *terraform.State) error {
+						policyID = s.RootModule().Resources["tsuga_retention_policy.test"].Primary.ID
+						return nil
+					},
+				),
+			},
+			// Removing the policy from the configuration only disables it.
+			{
+				Config: providerConfig + team,
+			},
+			// The disabled policy can be imported back, then deleted by the post-test destroy.
+			{
+				Config:             providerConfig + team + policy(false, ""),
+				ResourceName:       "tsuga_retention_policy.test",
+				ImportState:        true,
+				ImportStatePersist: true,
+				ImportStateIdFunc: func(*terraform.State) (string, error) {
+					return policyID, nil
+				},
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					for _, s := range states {
+						if s.ID != policyID {
+							continue
+						}
+						if got := s.Attributes["is_enabled"]; got != "false" {
+							return fmt.Errorf("expected the retention policy to be disabled, got is_enabled = %s", got)
+						}
+						return nil
+					}
+					return fmt.Errorf("retention policy %s not found in imported state", policyID)
+				},
+			},
+		},
+	})
+}
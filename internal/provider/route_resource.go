@@ -7,6 +7,9 @@ import (
 	"io"
 	"net/http"
 
+	"terraform-provider-tsuga/internal/customtypes"
+	"terraform-provider-tsuga/internal/filterexpression"
+	"terraform-provider-tsuga/internal/grok"
 	"terraform-provider-tsuga/internal/resource_route"
 	"terraform-provider-tsuga/internal/resource_team"
 
@@ -21,6 +24,7 @@ import (
 var _ resource.Resource = (*routeResource)(nil)
 var _ resource.ResourceWithConfigure = (*routeResource)(nil)
 var _ resource.ResourceWithImportState = (*routeResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*routeResource)(nil)
 var _ resource.ResourceWithValidateConfig = (*routeResource)(nil)
 
 func NewRouteResource() resource.Resource {
@@ -53,7 +57,7 @@ func (r *routeResource) Metadata(_ context.Context, req resource.MetadataRequest
 }
 
 func (r *routeResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = resource_route.RouteResourceSchema(ctx)
+	resp.Schema = withTimeouts(ctx, withOnDestroy(withDeletionProtection(resource_route.RouteResourceSchema(ctx)), "is_enabled"))
 }
 
 func (r *routeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -63,6 +67,9 @@ func (r *routeResource) ValidateConfig(ctx context.Context, req resource.Validat
 		return
 	}
 
+	resp.Diagnostics.Append(validateQuerySyntax(config.Query.StringValue, "query")...)
+	resp.Diagnostics.Append(validateFilterExpression(ctx, config.FilterExpression, "filter_expression")...)
+
 	// Validate processors: each processor must have exactly one of mapper, parse_attribute, creator, or split
 	if !config.Processors.IsNull() && !config.Processors.IsUnknown() {
 		diags := r.validateProcessors(ctx, config.Processors, resource_route.MaxSplitDepth, "processors")
@@ -104,6 +111,17 @@ func (r *routeResource) validateProcessors(ctx context.Context, processors types
 			)
 		}
 
+		if proc.ParseAttribute != nil && proc.ParseAttribute.Grok != nil {
+			diags.Append(r.validateGrok(ctx, proc.ParseAttribute.Grok, fmt.Sprintf("%s[%d].parse_attribute.grok", pathPrefix, i))...)
+		}
+
+		if proc.Creator != nil && proc.Creator.Category != nil {
+			for j, clause := range proc.Creator.Category.Clauses {
+				diags.Append(validateQuerySyntax(clause.Query.StringValue, fmt.Sprintf("%s[%d].creator.category.clauses[%d].query", pathPrefix, i, j))...)
+				diags.Append(validateFilterExpression(ctx, clause.FilterExpression, fmt.Sprintf("%s[%d].creator.category.clauses[%d].filter_expression", pathPrefix, i, j))...)
+			}
+		}
+
 		// Validate nested processors in split items
 		if !proc.Split.IsNull() && !proc.Split.IsUnknown() && depth > 0 {
 			var splitModel resource_route.SplitModel
@@ -113,6 +131,8 @@ func (r *routeResource) validateProcessors(ctx context.Context, processors types
 			}
 
 			for j, item := range splitModel.Items {
+				diags.Append(validateQuerySyntax(item.Query.StringValue, fmt.Sprintf("%s[%d].split.items[%d].query", pathPrefix, i, j))...)
+				diags.Append(validateFilterExpression(ctx, item.FilterExpression, fmt.Sprintf("%s[%d].split.items[%d].filter_expression", pathPrefix, i, j))...)
 				if !item.Processors.IsNull() && !item.Processors.IsUnknown() {
 					nestedDiags := r.validateProcessors(ctx, item.Processors, depth-1, fmt.Sprintf("%s[%d].split.items[%d].processors", pathPrefix, i, j))
 					diags.Append(nestedDiags...)
@@ -124,10 +144,66 @@ func (r *routeResource) validateProcessors(ctx context.Context, processors types
 	return diags
 }
 
+// validateGrok compiles the Grok rules with the offline engine and checks that every
+// sample is matched by at least one rule, so broken rules fail at plan time.
+func (r *routeResource) validateGrok(ctx context.Context, model *resource_route.ParseGrokModel, pathPrefix string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if model.Rules.IsNull() || model.Rules.IsUnknown() {
+		return diags
+	}
+
+	var rules []types.String
+	diags.Append(model.Rules.ElementsAs(ctx, &rules, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	compiled := make([]*grok.Rule, 0, len(rules))
+	for i, rule := range rules {
+		if rule.IsNull() || rule.IsUnknown() {
+			// Samples cannot be checked against a partially known rule set.
+			return diags
+		}
+		c, err := grok.Compile(rule.ValueString())
+		if err != nil {
+			diags.AddError("Invalid Grok rule", fmt.Sprintf("%s.rules[%d]: %s", pathPrefix, i, err))
+			continue
+		}
+		compiled = append(compiled, c)
+	}
+	if diags.HasError() || model.Samples.IsNull() || model.Samples.IsUnknown() {
+		return diags
+	}
+
+	var samples []types.String
+	diags.Append(model.Samples.ElementsAs(ctx, &samples, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for i, sample := range samples {
+		if sample.IsNull() || sample.IsUnknown() {
+			continue
+		}
+		if _, ok := grok.MatchFirst(compiled, sample.ValueString()); !ok {
+			diags.AddError(
+				"Grok sample does not match",
+				fmt.Sprintf("%s.samples[%d]: none of the rules match %q.", pathPrefix, i, sample.ValueString()),
+			)
+		}
+	}
+
+	return diags
+}
+
 func (r *routeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *routeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnDeletionProtection(ctx, "route", req, resp)
+}
+
 func (r *routeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan resource_route.RouteModel
 
@@ -136,6 +212,15 @@ func (r *routeResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	requestBody, diags := r.buildRouteRequestBody(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -148,7 +233,11 @@ func (r *routeResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	newState.OnDestroy = plan.OnDestroy
+	newState.DeletionProtection = plan.DeletionProtection
+	newState.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(preserveFilterExpressions(req.Plan.Raw, &resp.State)...)
 }
 
 func (r *routeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -158,6 +247,15 @@ func (r *routeResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	path := fmt.Sprintf("/v1/routes/%s", state.Id.ValueString())
 	httpResp, err := r.client.doRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -194,7 +292,11 @@ func (r *routeResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	newState.OnDestroy = state.OnDestroy
+	newState.DeletionProtection = state.DeletionProtection
+	newState.Timeouts = state.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(preserveFilterExpressions(req.State.Raw, &resp.State)...)
 }
 
 func (r *routeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -207,6 +309,15 @@ func (r *routeResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	requestBody, diags := r.buildRouteRequestBody(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -220,7 +331,11 @@ func (r *routeResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	newState.OnDestroy = plan.OnDestroy
+	newState.DeletionProtection = plan.DeletionProtection
+	newState.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(preserveFilterExpressions(req.Plan.Raw, &resp.State)...)
 }
 
 func (r *routeResource) buildRouteRequestBody(ctx context.Context, plan resource_route.RouteModel) (map[string]interface{}, diag.Diagnostics) {
@@ -228,6 +343,8 @@ func (r *routeResource) buildRouteRequestBody(ctx context.Context, plan resource
 
 	processors, expandDiags := expandRouteProcessors(ctx, plan.Processors, resource_route.MaxSplitDepth)
 	diags.Append(expandDiags...)
+	query, queryDiags := resolveQuery(ctx, plan.Query.StringValue, plan.FilterExpression)
+	diags.Append(queryDiags...)
 	tags, tagDiags := expandTags(ctx, plan.Tags)
 	diags.Append(tagDiags...)
 	if diags.HasError() {
@@ -237,7 +354,7 @@ func (r *routeResource) buildRouteRequestBody(ctx context.Context, plan resource
 	body := map[string]interface{}{
 		"name":       plan.Name.ValueString(),
 		"isEnabled":  plan.IsEnabled.ValueBool(),
-		"query":      plan.Query.ValueString(),
+		"query":      query,
 		"owner":      plan.Owner.ValueString(),
 		"processors": processors,
 	}
@@ -294,7 +411,37 @@ func (r *routeResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.Append(deletionProtectionError("route", state.Id))
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	path := fmt.Sprintf("/v1/routes/%s", state.Id.ValueString())
+
+	switch state.OnDestroy.ValueString() {
+	case onDestroyAbandon:
+		return
+	case onDestroyDisable:
+		state.IsEnabled = types.BoolValue(false)
+		requestBody, diags := r.buildRouteRequestBody(ctx, state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(disableOnDestroy(ctx, r.client, path, requestBody, "route")...)
+		return
+	}
+
 	httpResp, err := r.client.doRequest(ctx, http.MethodDelete, path, map[string]interface{}{})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete route: %s", err))
@@ -377,7 +524,7 @@ func expandRouteProcessors(ctx context.Context, processors types.List, depth int
 		if p.Creator != nil {
 			setCount++
 			apiProc.Type = "creator"
-			params, pd := expandCreatorParams(p.Creator)
+			params, pd := expandCreatorParams(ctx, p.Creator)
 			diags.Append(pd...)
 			if diags.HasError() {
 				return nil, diags
@@ -496,7 +643,7 @@ func expandParseAttributeParams(ctx context.Context, p *resource_route.ParseAttr
 	return nil, diags
 }
 
-func expandCreatorParams(c *resource_route.CreatorModel) (map[string]interface{}, diag.Diagnostics) {
+func expandCreatorParams(ctx context.Context, c *resource_route.CreatorModel) (map[string]interface{}, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	if c.FormatString != nil {
 		return map[string]interface{}{
@@ -519,8 +666,10 @@ func expandCreatorParams(c *resource_route.CreatorModel) (map[string]interface{}
 	if c.Category != nil {
 		clauses := make([]map[string]interface{}, 0, len(c.Category.Clauses))
 		for _, cl := range c.Category.Clauses {
+			query, qd := resolveQuery(ctx, cl.Query.StringValue, cl.FilterExpression)
+			diags.Append(qd...)
 			clauses = append(clauses, map[string]interface{}{
-				"query": cl.Query.ValueString(),
+				"query": query,
 				"value": cl.Value.ValueString(),
 			})
 		}
@@ -544,11 +693,13 @@ func expandSplitItems(ctx context.Context, items []resource_route.SplitItemModel
 	for _, item := range items {
 		procs, pd := expandRouteProcessors(ctx, item.Processors, depth)
 		diags.Append(pd...)
+		query, qd := resolveQuery(ctx, item.Query.StringValue, item.FilterExpression)
+		diags.Append(qd...)
 		if diags.HasError() {
 			return nil, diags
 		}
 		result = append(result, map[string]interface{}{
-			"query":      item.Query.ValueString(),
+			"query":      query,
 			"processors": procs,
 		})
 	}
@@ -569,17 +720,18 @@ func flattenRoute(ctx context.Context, data routeAPIData) (resource_route.RouteM
 		tagsList = types.ListNull(types.ObjectType{AttrTypes: resource_team.TagsValue{}.AttributeTypes(ctx)})
 	}
 
-	query := types.StringValue(data.Query)
+	query := customtypes.NewQueryValue(data.Query)
 
 	state := resource_route.RouteModel{
-		Id:          types.StringValue(data.ID),
-		Name:        types.StringValue(data.Name),
-		Description: stringValueOrNull(data.Description),
-		IsEnabled:   types.BoolValue(data.IsEnabled),
-		Query:       query,
-		Owner:       types.StringValue(data.Owner),
-		Tags:        tagsList,
-		Processors:  procs,
+		Id:               types.StringValue(data.ID),
+		Name:             types.StringValue(data.Name),
+		Description:      stringValueOrNull(data.Description),
+		IsEnabled:        types.BoolValue(data.IsEnabled),
+		Query:            query,
+		FilterExpression: filterexpression.Null(),
+		Owner:            types.StringValue(data.Owner),
+		Tags:             tagsList,
+		Processors:       procs,
 	}
 
 	return state, diags
@@ -819,8 +971,9 @@ func flattenCreator(params map[string]interface{}) attr.Value {
 			for _, cl := range clausesRaw {
 				m, _ := cl.(map[string]interface{})
 				vals = append(vals, types.ObjectValueMust(resource_route.CreatorCategoryClauseAttrTypes(), map[string]attr.Value{
-					"query": types.StringValue(fmt.Sprintf("%v", m["query"])),
-					"value": types.StringValue(fmt.Sprintf("%v", m["value"])),
+					"query":             customtypes.NewQueryValue(fmt.Sprintf("%v", m["query"])),
+					"filter_expression": filterexpression.Null(),
+					"value":             types.StringValue(fmt.Sprintf("%v", m["value"])),
 				}))
 			}
 			clausesVal, _ = types.ListValue(clauseType, vals)
@@ -870,8 +1023,9 @@ func flattenSplit(ctx context.Context, params map[string]interface{}, depth int)
 			return types.ObjectNull(resource_route.SplitAttrTypesAtDepth(ctx, depth)), diags
 		}
 		items = append(items, types.ObjectValueMust(resource_route.SplitItemAttrTypesAtDepth(ctx, depth), map[string]attr.Value{
-			"query":      types.StringValue(fmt.Sprintf("%v", m["query"])),
-			"processors": procVal,
+			"query":             customtypes.NewQueryValue(fmt.Sprintf("%v", m["query"])),
+			"filter_expression": filterexpression.Null(),
+			"processors":        procVal,
 		}))
 	}
 	listVal, _ := types.ListValue(elemType, items)
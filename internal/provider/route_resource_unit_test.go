@@ -1,6 +1,9 @@
 package provider
 
 import (
+	"context"
+	"strings"
+	"terraform-provider-tsuga/internal/customtypes"
 	"terraform-provider-tsuga/internal/resource_route"
 	"testing"
 
@@ -12,14 +15,14 @@ func TestExpandCreatorParams_Category(t *testing.T) {
 		Category: &resource_route.CreatorCategoryModel{
 			TargetAttribute: types.StringValue("severity"),
 			Clauses: []resource_route.CreatorCategoryClauseModel{
-				{Query: types.StringValue("status_code:>=500"), Value: types.StringValue("error")},
-				{Query: types.StringValue("status_code:>=400"), Value: types.StringValue("warning")},
+				{Query: customtypes.NewQueryValue("status_code:>=500"), Value: types.StringValue("error")},
+				{Query: customtypes.NewQueryValue("status_code:>=400"), Value: types.StringValue("warning")},
 			},
 			DefaultValue: types.StringValue("info"),
 		},
 	}
 
-	params, diags := expandCreatorParams(creator)
+	params, diags := expandCreatorParams(context.Background(), creator)
 	if diags.HasError() {
 		t.Fatalf("unexpected diagnostics: %v", diags)
 	}
@@ -51,13 +54,13 @@ func TestExpandCreatorParams_CategoryOmitsUnsetDefaultValue(t *testing.T) {
 		Category: &resource_route.CreatorCategoryModel{
 			TargetAttribute: types.StringValue("severity"),
 			Clauses: []resource_route.CreatorCategoryClauseModel{
-				{Query: types.StringValue("status_code:>=500"), Value: types.StringValue("error")},
+				{Query: customtypes.NewQueryValue("status_code:>=500"), Value: types.StringValue("error")},
 			},
 			DefaultValue: types.StringNull(),
 		},
 	}
 
-	params, diags := expandCreatorParams(creator)
+	params, diags := expandCreatorParams(context.Background(), creator)
 	if diags.HasError() {
 		t.Fatalf("unexpected diagnostics: %v", diags)
 	}
@@ -65,3 +68,44 @@ func TestExpandCreatorParams_CategoryOmitsUnsetDefaultValue(t *testing.T) {
 		t.Fatalf("expected defaultValue to be omitted when null, got %#v", params["defaultValue"])
 	}
 }
+
+func TestValidateGrok(t *testing.T) {
+	ctx := context.Background()
+	r := &routeResource{}
+
+	grokModel := func(rules, samples []string) *resource_route.ParseGrokModel {
+		rulesVal, _ := types.ListValueFrom(ctx, types.StringType, rules)
+		samplesVal := types.ListNull(types.StringType)
+		if samples != nil {
+			samplesVal, _ = types.ListValueFrom(ctx, types.StringType, samples)
+		}
+		return &resource_route.ParseGrokModel{
+			AttributeName: types.StringValue("message"),
+			Rules:         rulesVal,
+			Samples:       samplesVal,
+		}
+	}
+
+	valid := grokModel(
+		[]string{`%{IPV4:client_ip} %{WORD:method} %{INT:status:integer}`, `%{GREEDYDATA:message}`},
+		[]string{"10.0.0.1 GET 200", "anything"},
+	)
+	if diags := r.validateGrok(ctx, valid, "processors[0].parse_attribute.grok"); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	unknownPattern := grokModel([]string{`%{NOT_A_PATTERN:x}`}, nil)
+	diags := r.validateGrok(ctx, unknownPattern, "processors[0].parse_attribute.grok")
+	if !diags.HasError() {
+		t.Fatalf("expected an error for an unknown pattern")
+	}
+	if got := diags[0].Detail(); !strings.Contains(got, "processors[0].parse_attribute.grok.rules[0]") || !strings.Contains(got, "NOT_A_PATTERN") {
+		t.Fatalf("unexpected error detail: %s", got)
+	}
+
+	unmatched := grokModel([]string{`^%{INT:status}$`}, []string{"200", "not a number"})
+	diags = r.validateGrok(ctx, unmatched, "processors[0].parse_attribute.grok")
+	if len(diags) != 1 || !strings.Contains(diags[0].Detail(), "samples[1]") {
+		t.Fatalf("expected a single error for samples[1], got %v", diags)
+	}
+}
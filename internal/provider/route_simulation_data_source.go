@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"terraform-provider-tsuga/internal/datasource_route_simulation"
+	"terraform-provider-tsuga/internal/resource_route"
+	"terraform-provider-tsuga/internal/routesim"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*routeSimulationDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*routeSimulationDataSource)(nil)
+var _ datasource.DataSourceWithConfigValidators = (*routeSimulationDataSource)(nil)
+
+func NewRouteSimulationDataSource() datasource.DataSource {
+	return &routeSimulationDataSource{}
+}
+
+// routeSimulationDataSource interprets route processors locally. The client is only
+// used to fetch the processors of an existing route when route_id is set.
+type routeSimulationDataSource struct {
+	client *TsugaClient
+}
+
+func (d *routeSimulationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*TsugaClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *TsugaClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *routeSimulationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_route_simulation"
+}
+
+func (d *routeSimulationDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("route_id"),
+			path.MatchRoot("processors"),
+		),
+	}
+}
+
+func (d *routeSimulationDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_route_simulation.RouteSimulationDataSourceSchema(ctx)
+}
+
+func (d *routeSimulationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config datasource_route_simulation.RouteSimulationModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var processors []routeAPIProcessor
+	if !config.RouteId.IsNull() {
+		processors = d.readRouteProcessors(ctx, config.RouteId.ValueString(), &resp.Diagnostics)
+	} else {
+		var diags diag.Diagnostics
+		processors, diags = expandRouteProcessors(ctx, config.Processors, resource_route.MaxSplitDepth)
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pipeline, err := compileRouteSimulation(processors)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid route processors", err.Error())
+		return
+	}
+
+	events, diags := expandStringList(ctx, config.Events)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	results := make([]attr.Value, 0, len(events))
+	for i, raw := range events {
+		var event routesim.Event
+		if err := json.Unmarshal([]byte(raw), &event); err != nil || event == nil {
+			resp.Diagnostics.AddError("Invalid event", fmt.Sprintf("events[%d]: the event must be a JSON object.", i))
+			continue
+		}
+
+		result := pipeline.Run(event)
+		encoded, err := json.Marshal(result.Event)
+		if err != nil {
+			resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to encode simulated event: %s", err))
+			return
+		}
+		applied, diags := types.ListValueFrom(ctx, types.StringType, nonNilStrings(result.Applied))
+		resp.Diagnostics.Append(diags...)
+		skipped, diags := types.ListValueFrom(ctx, types.StringType, nonNilStrings(result.Skipped))
+		resp.Diagnostics.Append(diags...)
+
+		results = append(results, types.ObjectValueMust(datasource_route_simulation.ResultAttrTypes(), map[string]attr.Value{
+			"event":              types.StringValue(string(encoded)),
+			"applied_processors": applied,
+			"skipped_processors": skipped,
+		}))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resultsList, diags := types.ListValue(types.ObjectType{AttrTypes: datasource_route_simulation.ResultAttrTypes()}, results)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config.Results = resultsList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func (d *routeSimulationDataSource) readRouteProcessors(ctx context.Context, id string, diags *diag.Diagnostics) []routeAPIProcessor {
+	httpResp, err := d.client.doRequest(ctx, http.MethodGet, fmt.Sprintf("/v1/routes/%s", url.PathEscape(id)), nil)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read route: %s", err))
+		return nil
+	}
+	defer func() { _ = httpResp.Body.Close() }()
+
+	if httpResp.StatusCode == http.StatusNotFound {
+		diags.AddError("Route not found", fmt.Sprintf("No route was found with id %q.", id))
+		return nil
+	}
+
+	if err := d.client.checkResponse(httpResp); err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Unable to read route: %s", err))
+		return nil
+	}
+
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		diags.AddError("Parse Error", fmt.Sprintf("Unable to read response body: %s", err))
+		return nil
+	}
+
+	var apiResp routeAPIResponse
+	if err := json.Unmarshal(body, &apiResp); err != nil {
+		diags.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err))
+		return nil
+	}
+
+	return apiResp.Data.Processors
+}
+
+// compileRouteSimulation hands processors to the simulator in their API form. The
+// JSON round trip turns the typed slices built by expandRouteProcessors into the
+// generic values the API would receive.
+func compileRouteSimulation(processors []routeAPIProcessor) (*routesim.Pipeline, error) {
+	encoded, err := json.Marshal(processors)
+	if err != nil {
+		return nil, err
+	}
+	var simProcessors []routesim.Processor
+	if err := json.Unmarshal(encoded, &simProcessors); err != nil {
+		return nil, err
+	}
+	return routesim.Compile(simProcessors)
+}
+
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
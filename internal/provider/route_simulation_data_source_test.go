@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRouteSimulationDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "tsuga_route_simulation" "test" {
+  processors = [
+    {
+      id = "message-standardizer"
+      mapper = {
+        map_attributes = [
+          {
+            origin_attribute = "msg"
+            target_attribute = "message"
+            override_target  = true
+          }
+        ]
+      }
+    },
+    {
+      id = "access-log-parser"
+      parse_attribute = {
+        grok = {
+          attribute_name = "message"
+          rules          = ["%%{WORD:http.method} %%{NOTSPACE:http.url} %%{INT:http.status_code:integer}"]
+        }
+      }
+    },
+    {
+      id = "status-splitter"
+      split = {
+        items = [
+          {
+            filter_expression = {
+              terms = [{ field = "http.status_code", operator = "greater_than_or_equal", values = ["500"] }]
+            }
+            processors = [
+              {
+                id = "error-category"
+                creator = {
+                  category = {
+                    target_attribute = "severity"
+                    clauses          = [{ query = "http.method:POST", value = "critical" }]
+                    default_value    = "error"
+                  }
+                }
+              }
+            ]
+          }
+        ]
+      }
+    }
+  ]
+
+  events = [
+    jsonencode({ msg = "POST /checkout 503" }),
+    jsonencode({ msg = "GET /home 200" }),
+    jsonencode({ note = "no message" }),
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.tsuga_route_simulation.test", "results.#", "3"),
+					resource.TestCheckResourceAttr("data.tsuga_route_simulation.test", "results.0.event", `{"http":{"method":"POST","status_code":503,"url":"/checkout"},"message":"POST /checkout 503","severity":"critical"}`),
+					resource.TestCheckResourceAttr("data.tsuga_route_simulation.test", "results.0.applied_processors.#", "4"),
+					resource.TestCheckResourceAttr("data.tsuga_route_simulation.test", "results.0.applied_processors.3", "error-category"),
+					resource.TestCheckResourceAttr("data.tsuga_route_simulation.test", "results.1.event", `{"http":{"method":"GET","status_code":200,"url":"/home"},"message":"GET /home 200"}`),
+					resource.TestCheckResourceAttr("data.tsuga_route_simulation.test", "results.1.applied_processors.#", "3"),
+					resource.TestCheckResourceAttr("data.tsuga_route_simulation.test", "results.2.skipped_processors.#", "1"),
+					resource.TestCheckResourceAttr("data.tsuga_route_simulation.test", "results.2.skipped_processors.0", `access-log-parser: attribute "message" is not set`),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"terraform-provider-tsuga/internal/datasource_services"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*serviceDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*serviceDataSource)(nil)
+
+// defaultServiceGraphWindow is the service graph window when `graph_window` is unset.
+const defaultServiceGraphWindow = time.Hour
+
+func NewServiceDataSource() datasource.DataSource {
+	return &serviceDataSource{}
+}
+
+type serviceDataSource struct {
+	client *TsugaClient
+}
+
+func (d *serviceDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*TsugaClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *TsugaClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *serviceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service"
+}
+
+func (d *serviceDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_services.ServiceDataSourceSchema(ctx)
+}
+
+func (d *serviceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config datasource_services.ServiceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	window := defaultServiceGraphWindow
+	if !config.GraphWindow.IsNull() {
+		var err error
+		window, err = parseDuration(config.GraphWindow.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("graph_window"), "Invalid Duration", err.Error())
+			return
+		}
+	}
+
+	id := config.Id.ValueString()
+	servicePath := fmt.Sprintf("/v1/services/%s", url.PathEscape(id))
+	var serviceResp serviceAPIResponse
+	resp.Diagnostics.Append(d.client.fetchJSON(ctx, http.MethodGet, servicePath, nil, "read service", &serviceResp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	to := time.Now()
+	graphQuery := url.Values{}
+	graphQuery.Set("from", strconv.FormatInt(to.Add(-window).Unix(), 10))
+	graphQuery.Set("to", strconv.FormatInt(to.Unix(), 10))
+	if !config.GraphQuery.IsNull() {
+		graphQuery.Set("query", config.GraphQuery.ValueString())
+	}
+	var graphResp serviceGraphAPIResponse
+	graphPath := fmt.Sprintf("/v1/services/%s/graph?%s", url.PathEscape(id), graphQuery.Encode())
+	resp.Diagnostics.Append(d.client.fetchJSON(ctx, http.MethodGet, graphPath, nil, "read service graph", &graphResp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	item, diags := flattenService(ctx, serviceResp.Data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config.ServiceItemModel = item
+
+	name := serviceResp.Data.ServiceName
+	edges := []attr.Value{}
+	dependencies, dependents := []string{}, []string{}
+	for _, e := range graphResp.Data.Edges {
+		edges = append(edges, types.ObjectValueMust(datasource_services.GraphEdgeAttrTypes(), map[string]attr.Value{
+			"from":              types.StringValue(e.From.Name),
+			"from_env":          stringValueOrNull(e.From.Env),
+			"to":                types.StringValue(e.To.Name),
+			"to_env":            stringValueOrNull(e.To.Env),
+			"database_system":   stringValueOrNull(e.To.DatabaseSystem),
+			"count":             types.Int64Value(int64(e.Count)),
+			"error_count":       types.Int64Value(int64(e.ErrorCount)),
+			"client_span_count": types.Int64Value(int64(e.ClientSpanCount)),
+		}))
+		if e.From.Name == name && e.To.Name != name {
+			dependencies = appendUnique(dependencies, e.To.Name)
+		}
+		if e.To.Name == name && e.From.Name != name {
+			dependents = appendUnique(dependents, e.From.Name)
+		}
+	}
+
+	config.GraphEdges, diags = types.ListValue(types.ObjectType{AttrTypes: datasource_services.GraphEdgeAttrTypes()}, edges)
+	resp.Diagnostics.Append(diags...)
+	config.Dependencies, diags = types.ListValueFrom(ctx, types.StringType, dependencies)
+	resp.Diagnostics.Append(diags...)
+	config.Dependents, diags = types.ListValueFrom(ctx, types.StringType, dependents)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func appendUnique(values []string, v string) []string {
+	for _, existing := range values {
+		if existing == v {
+			return values
+		}
+	}
+	return append(values, v)
+}
+
+type serviceAPIResponse struct {
+	Data serviceAPIData `json:"data"`
+}
+
+type serviceGraphAPIResponse struct {
+	Data struct {
+		ClusterID string `json:"clusterId"`
+		Edges     []struct {
+			From struct {
+				Name string `json:"name"`
+				Env  string `json:"env"`
+			} `json:"from"`
+			To struct {
+				Name           string `json:"name"`
+				Env            string `json:"env"`
+				DatabaseSystem string `json:"databaseSystem"`
+			} `json:"to"`
+			Count           float64 `json:"count"`
+			ErrorCount      float64 `json:"errorCount"`
+			ClientSpanCount float64 `json:"clientSpanCount"`
+		} `json:"edges"`
+	} `json:"data"`
+}
@@ -0,0 +1,257 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"terraform-provider-tsuga/internal/datasource_services"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*servicesDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*servicesDataSource)(nil)
+
+// listPageSize is the page size of paginated list requests, the API maximum.
+const listPageSize = 1000
+
+func NewServicesDataSource() datasource.DataSource {
+	return &servicesDataSource{}
+}
+
+type servicesDataSource struct {
+	client *TsugaClient
+}
+
+func (d *servicesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*TsugaClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *TsugaClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *servicesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_services"
+}
+
+func (d *servicesDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_services.ServicesDataSourceSchema(ctx)
+}
+
+func (d *servicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config datasource_services.ServicesModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var seenAfter time.Time
+	if !config.LastSeenWithin.IsNull() {
+		window, err := parseDuration(config.LastSeenWithin.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("last_seen_within"), "Invalid Duration", err.Error())
+			return
+		}
+		seenAfter = time.Now().Add(-window)
+	}
+
+	var services []serviceAPIData
+	for offset := 0; ; offset += listPageSize {
+		query := url.Values{}
+		query.Set("limit", strconv.Itoa(listPageSize))
+		query.Set("offset", strconv.Itoa(offset))
+		if !config.ClusterId.IsNull() {
+			query.Set("clusterId", config.ClusterId.ValueString())
+		}
+
+		var page servicesListAPIResponse
+		resp.Diagnostics.Append(d.client.fetchJSON(ctx, http.MethodGet, "/v1/services?"+query.Encode(), nil, "list services", &page)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		services = append(services, page.Data...)
+		total := page.Metadata.Pagination.TotalCount
+		if len(page.Data) < listPageSize || (total > 0 && offset+len(page.Data) >= total) {
+			break
+		}
+	}
+
+	items := []datasource_services.ServiceItemModel{}
+	for _, s := range services {
+		if !serviceMatches(s, config, seenAfter) {
+			continue
+		}
+		item, diags := flattenService(ctx, s)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		items = append(items, item)
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: datasource_services.ServiceAttrTypes()}, items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config.Services = list
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// serviceMatches reports whether a service passes the filters of config, with
+// seenAfter the earliest last-seen time allowed, or zero.
+func serviceMatches(s serviceAPIData, config datasource_services.ServicesModel, seenAfter time.Time) bool {
+	if !config.Team.IsNull() && !containsFold(s.teamNames(), config.Team.ValueString()) {
+		return false
+	}
+	if !config.Language.IsNull() && !containsFold(s.languageNames(), config.Language.ValueString()) {
+		return false
+	}
+	if !config.CloudProvider.IsNull() && !containsFold(s.cloudProviderNames(), config.CloudProvider.ValueString()) {
+		return false
+	}
+	if !seenAfter.IsZero() {
+		lastSeen, err := time.Parse(time.RFC3339, s.LastSeenAt)
+		if err != nil || lastSeen.Before(seenAfter) {
+			return false
+		}
+	}
+	return true
+}
+
+// containsFold reports whether values holds want, compared case-insensitively.
+func containsFold(values []string, want string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, want) {
+			return true
+		}
+	}
+	return false
+}
+
+func flattenService(ctx context.Context, s serviceAPIData) (datasource_services.ServiceItemModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	list := func(values []string) types.List {
+		if values == nil {
+			values = []string{}
+		}
+		l, d := types.ListValueFrom(ctx, types.StringType, values)
+		diags.Append(d...)
+		return l
+	}
+
+	return datasource_services.ServiceItemModel{
+		Id:                  types.StringValue(s.ID),
+		Name:                types.StringValue(s.ServiceName),
+		Namespace:           stringValueOrNull(s.ServiceNamespace),
+		Env:                 stringValueOrNull(s.Env),
+		Teams:               list(s.teamNames()),
+		Versions:            list(seenValues(s.Versions, func(v serviceAPISeen) string { return v.Version })),
+		Languages:           list(s.languageNames()),
+		Runtimes:            list(seenValues(s.Runtimes, func(v serviceAPISeen) string { return v.Runtime })),
+		Frameworks:          list(seenValues(s.Frameworks, func(v serviceAPISeen) string { return v.Framework })),
+		ServerProtocols:     list(seenValues(s.ServerProtocols, func(v serviceAPISeen) string { return v.Protocol })),
+		ClientProtocols:     list(seenValues(s.ClientProtocols, func(v serviceAPISeen) string { return v.Protocol })),
+		CloudPlatforms:      list(seenValues(s.CloudPlatforms, func(v serviceAPISeen) string { return v.Platform })),
+		CloudProviders:      list(s.cloudProviderNames()),
+		Sources:             list(s.Sources),
+		FirstSeenAt:         stringValueOrNull(s.FirstSeenAt),
+		LastSeenAt:          stringValueOrNull(s.LastSeenAt),
+		LogsCount24h:        types.Int64Value(int64(s.LogsCount24h)),
+		ErrorLogsCount24h:   types.Int64Value(int64(s.ErrorLogsCount24h)),
+		TracesCount24h:      types.Int64Value(int64(s.TracesCount24h)),
+		ErrorTracesCount24h: types.Int64Value(int64(s.ErrorTracesCount24h)),
+	}, diags
+}
+
+// seenValues returns the values of a list of observations, such as the
+// languages a service was seen using, with field selecting the value.
+func seenValues(seen []serviceAPISeen, field func(serviceAPISeen) string) []string {
+	values := make([]string, 0, len(seen))
+	for _, s := range seen {
+		values = append(values, field(s))
+	}
+	return values
+}
+
+type serviceAPIData struct {
+	ID                  string           `json:"id"`
+	ServiceName         string           `json:"serviceName"`
+	ServiceNamespace    string           `json:"serviceNamespace"`
+	Env                 string           `json:"env"`
+	Teams               []serviceAPISeen `json:"teams"`
+	Versions            []serviceAPISeen `json:"versions"`
+	Languages           []serviceAPISeen `json:"languages"`
+	Runtimes            []serviceAPISeen `json:"runtimes"`
+	Frameworks          []serviceAPISeen `json:"frameworks"`
+	ServerProtocols     []serviceAPISeen `json:"serverProtocols"`
+	ClientProtocols     []serviceAPISeen `json:"clientProtocols"`
+	CloudPlatforms      []serviceAPISeen `json:"cloudPlatforms"`
+	CloudProviders      []serviceAPISeen `json:"cloudProviders"`
+	FirstSeenAt         string           `json:"firstSeenAt"`
+	LastSeenAt          string           `json:"lastSeenAt"`
+	Sources             []string         `json:"sources"`
+	LogsCount24h        float64          `json:"logsCount24h"`
+	ErrorLogsCount24h   float64          `json:"errorLogsCount24h"`
+	TracesCount24h      float64          `json:"tracesCount24h"`
+	ErrorTracesCount24h float64          `json:"errorTracesCount24h"`
+}
+
+func (s serviceAPIData) teamNames() []string {
+	return seenValues(s.Teams, func(v serviceAPISeen) string { return v.Team })
+}
+
+func (s serviceAPIData) languageNames() []string {
+	return seenValues(s.Languages, func(v serviceAPISeen) string { return v.Language })
+}
+
+func (s serviceAPIData) cloudProviderNames() []string {
+	return seenValues(s.CloudProviders, func(v serviceAPISeen) string { return v.Provider })
+}
+
+// serviceAPISeen is an observation of a service attribute, of which the API sets
+// the field naming the attribute.
+type serviceAPISeen struct {
+	Team       string `json:"team,omitempty"`
+	Version    string `json:"version,omitempty"`
+	Language   string `json:"language,omitempty"`
+	Runtime    string `json:"runtime,omitempty"`
+	Framework  string `json:"framework,omitempty"`
+	Protocol   string `json:"protocol,omitempty"`
+	Platform   string `json:"platform,omitempty"`
+	Provider   string `json:"provider,omitempty"`
+	LastSeenAt string `json:"lastSeenAt"`
+}
+
+type servicesListAPIResponse struct {
+	Data     []serviceAPIData    `json:"data"`
+	Metadata apiResponseMetadata `json:"metadata"`
+}
+
+// apiResponseMetadata is the metadata of paginated list responses.
+type apiResponseMetadata struct {
+	Pagination struct {
+		TotalCount int `json:"totalCount"`
+		PageCount  int `json:"pageCount"`
+	} `json:"pagination"`
+}
@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccServicesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "tsuga_services" "all" {}
+
+data "tsuga_services" "none" {
+  language = "no-such-language"
+}
+
+data "tsuga_service" "first" {
+  count        = length(data.tsuga_services.all.services) > 0 ? 1 : 0
+  id           = data.tsuga_services.all.services[0].id
+  graph_window = "1d"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.tsuga_services.all", "services.#"),
+					resource.TestCheckResourceAttr("data.tsuga_services.none", "services.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"terraform-provider-tsuga/internal/datasource_services"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestServiceMatches(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	service := serviceAPIData{
+		ServiceName:    "checkout",
+		Teams:          []serviceAPISeen{{Team: "payments"}},
+		Languages:      []serviceAPISeen{{Language: "Go"}},
+		CloudProviders: []serviceAPISeen{{Provider: "aws"}},
+		LastSeenAt:     now.Add(-2 * time.Hour).Format(time.RFC3339),
+	}
+	filters := func(team, language, provider string) datasource_services.ServicesModel {
+		value := func(s string) types.String {
+			if s == "" {
+				return types.StringNull()
+			}
+			return types.StringValue(s)
+		}
+		return datasource_services.ServicesModel{
+			Team:          value(team),
+			Language:      value(language),
+			CloudProvider: value(provider),
+		}
+	}
+
+	cases := []struct {
+		name      string
+		config    datasource_services.ServicesModel
+		seenAfter time.Time
+		want      bool
+	}{
+		{"no filters", filters("", "", ""), time.Time{}, true},
+		{"all filters match", filters("payments", "go", "AWS"), now.Add(-24 * time.Hour), true},
+		{"other team", filters("search", "", ""), time.Time{}, false},
+		{"other language", filters("", "python", ""), time.Time{}, false},
+		{"other cloud provider", filters("", "", "gcp"), time.Time{}, false},
+		{"not seen recently", filters("", "", ""), now.Add(-time.Hour), false},
+	}
+	for _, tc := range cases {
+		if got := serviceMatches(service, tc.config, tc.seenAfter); got != tc.want {
+			t.Errorf("%s: serviceMatches() = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
@@ -6,10 +6,12 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"terraform-provider-tsuga/internal/customtypes"
 	"terraform-provider-tsuga/internal/groupby"
 	"terraform-provider-tsuga/internal/resource_monitor"
 	"terraform-provider-tsuga/internal/resource_slo"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -21,6 +23,7 @@ var (
 	_ resource.Resource                   = (*sloResource)(nil)
 	_ resource.ResourceWithConfigure      = (*sloResource)(nil)
 	_ resource.ResourceWithImportState    = (*sloResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*sloResource)(nil)
 	_ resource.ResourceWithValidateConfig = (*sloResource)(nil)
 )
 
@@ -54,7 +57,7 @@ func (r *sloResource) Metadata(_ context.Context, req resource.MetadataRequest,
 }
 
 func (r *sloResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = resource_slo.SloResourceSchema(ctx)
+	resp.Schema = withTimeouts(ctx, withDeletionProtection(resource_slo.SloResourceSchema(ctx)))
 }
 
 func (r *sloResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -127,9 +130,13 @@ func (r *sloResource) validateSloQueryFormula(ctx context.Context, qf resource_s
 	}
 
 	for i, q := range queryModels {
+		diags.Append(validateFilterExpression(ctx, q.FilterExpression, fmt.Sprintf("%s.queries[%d].filter_expression", pathPrefix, i))...)
 		diags.Append(validateSloAggregate(q.Aggregate, fmt.Sprintf("%s.queries[%d].aggregate", pathPrefix, i))...)
 	}
 
+	formulas := []formulaAttribute{{value: qf.Formula, path: pathPrefix + ".formula"}}
+	diags.Append(validateFormulas(formulas, len(queryModels), pathPrefix+".queries")...)
+
 	return diags
 }
 
@@ -208,6 +215,31 @@ func (r *sloResource) ImportState(ctx context.Context, req resource.ImportStateR
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *sloResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnDeletionProtection(ctx, "SLO", req, resp)
+	planDefaultClusterIDs(ctx, r.client, req, resp)
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// Plan the alert ids Update will keep, so that only new or changed alerts show
+	// their id as known after apply. A list-index UseStateForUnknown would hand ids
+	// to the wrong alerts once the list is reordered.
+	var planned, prior types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("alerts"), &planned)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("alerts"), &prior)...)
+	if resp.Diagnostics.HasError() || planned.IsNull() || planned.IsUnknown() {
+		return
+	}
+
+	alerts, diags := planSloAlertIDs(ctx, planned, sloAlertRef(ctx, prior))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("alerts"), alerts)...)
+}
+
 func (r *sloResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan resource_slo.SloModel
 
@@ -216,6 +248,15 @@ func (r *sloResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// No prior alerts on create: every alert is sent without an id, so the API creates them all.
 	requestBody, diags := r.buildSloRequestBody(ctx, plan, nil)
 	resp.Diagnostics.Append(diags...)
@@ -229,7 +270,10 @@ func (r *sloResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
+	newState.DeletionProtection = plan.DeletionProtection
+	newState.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(preserveFilterExpressions(req.Plan.Raw, &resp.State)...)
 }
 
 func (r *sloResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -237,11 +281,24 @@ func (r *sloResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	// attribute is null; decoding the whole SloModel here would fail because nested fields
 	// such as configuration are non-pointer value structs that cannot represent null.
 	var id types.String
+	var deletionProtection types.Bool
+	var configuredTimeouts timeouts.Value
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("deletion_protection"), &deletionProtection)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &configuredTimeouts)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	readTimeout, diags := configuredTimeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// The prior state's alert order (matched by id) is the reference we flatten the API's
 	// unordered alerts back into, so a refresh doesn't churn the order. On import this is null,
 	// so the alerts come back in API order.
@@ -286,8 +343,11 @@ func (r *sloResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	newState.DeletionProtection = deletionProtection
+	newState.Timeouts = configuredTimeouts
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(preserveFilterExpressions(req.State.Raw, &resp.State)...)
 }
 
 func (r *sloResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -300,6 +360,15 @@ func (r *sloResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Pass the prior state's alerts so ids are matched to them by content rather than by index.
 	requestBody, diags := r.buildSloRequestBody(ctx, plan, sloAlertRef(ctx, state.Alerts))
 	resp.Diagnostics.Append(diags...)
@@ -314,7 +383,10 @@ func (r *sloResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
+	newState.DeletionProtection = plan.DeletionProtection
+	newState.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+	resp.Diagnostics.Append(preserveFilterExpressions(req.Plan.Raw, &resp.State)...)
 }
 
 func (r *sloResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -324,6 +396,20 @@ func (r *sloResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.Append(deletionProtectionError("SLO", state.Id))
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	path := fmt.Sprintf("/v1/slos/%s", state.Id.ValueString())
 	httpResp, err := r.client.doRequest(ctx, http.MethodDelete, path, map[string]interface{}{})
 	if err != nil {
@@ -628,6 +714,29 @@ func matchPriorAlertID(planned resource_slo.SloAlertModel, prior []resource_slo.
 	return ""
 }
 
+// planSloAlertIDs fills in the unknown ids of planned alerts with the prior alert
+// ids expandSloAlerts will send for them.
+func planSloAlertIDs(ctx context.Context, planned types.List, prior []resource_slo.SloAlertModel) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var alertModels []resource_slo.SloAlertModel
+	diags.Append(planned.ElementsAs(ctx, &alertModels, false)...)
+	if diags.HasError() {
+		return planned, diags
+	}
+
+	priorUsed := make([]bool, len(prior))
+	for i, a := range alertModels {
+		if id := matchPriorAlertID(a, prior, priorUsed); id != "" && a.Id.IsUnknown() {
+			alertModels[i].Id = types.StringValue(id)
+		}
+	}
+
+	list, listDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: resource_slo.SloAlertAttrTypes()}, alertModels)
+	diags.Append(listDiags...)
+	return list, diags
+}
+
 // sloAlertContentEqual reports whether two alerts have the same priority and configuration —
 // everything that identifies an alert except its server-assigned id.
 func sloAlertContentEqual(a, b resource_slo.SloAlertModel) bool {
@@ -682,7 +791,7 @@ func flattenSlo(ctx context.Context, data sloAPIData, alertRef []resource_slo.Sl
 		Description:   types.StringValue(data.Description),
 		Tags:          tags,
 		Configuration: config,
-		Target:        types.Float64Value(data.Target),
+		Target:        customtypes.NewFloat64Value(data.Target),
 		TimeframeDays: types.Int64Value(int64(data.TimeframeDays)),
 		Owner:         types.StringValue(data.Owner),
 		Permissions:   types.StringValue(data.Permissions),
@@ -754,7 +863,7 @@ func flattenSloTimeConfiguration(ctx context.Context, config sloAPIConfiguration
 		SliceSizeMinutes: types.Int64Value(int64(*config.SliceSizeMinutes)),
 		Threshold: resource_slo.SloTimeThresholdModel{
 			Operator: types.StringValue(config.Threshold.Operator),
-			Value:    types.Float64Value(config.Threshold.Value),
+			Value:    customtypes.NewFloat64Value(config.Threshold.Value),
 		},
 		GroupByFields:  groupByFields,
 		NoDataBehavior: types.StringValue(config.NoDataBehavior),
@@ -851,11 +960,11 @@ func sloAlertContentMatches(a sloAPIAlert, r resource_slo.SloAlertModel) bool {
 	case "burn-rate":
 		return a.Configuration.BurnRate != nil &&
 			r.Configuration.BurnRateSet() &&
-			*a.Configuration.BurnRate == r.Configuration.BurnRate.ValueFloat64()
+			customtypes.Float64Equal(*a.Configuration.BurnRate, r.Configuration.BurnRate.ValueFloat64())
 	case "threshold":
 		return a.Configuration.Threshold != nil &&
 			r.Configuration.ThresholdSet() &&
-			*a.Configuration.Threshold == r.Configuration.Threshold.ValueFloat64()
+			customtypes.Float64Equal(*a.Configuration.Threshold, r.Configuration.Threshold.ValueFloat64())
 	default:
 		return false
 	}
@@ -905,17 +1014,17 @@ func flattenSloAlerts(alerts []sloAPIAlert, ref []resource_slo.SloAlertModel) (t
 func flattenSloAlertConfiguration(config sloAPIAlertConfiguration) (attr.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	burnRate := types.Float64Null()
-	threshold := types.Float64Null()
+	burnRate := customtypes.NewFloat64Null()
+	threshold := customtypes.NewFloat64Null()
 
 	switch config.Type {
 	case "burn-rate":
 		if config.BurnRate != nil {
-			burnRate = types.Float64Value(*config.BurnRate)
+			burnRate = customtypes.NewFloat64Value(*config.BurnRate)
 		}
 	case "threshold":
 		if config.Threshold != nil {
-			threshold = types.Float64Value(*config.Threshold)
+			threshold = customtypes.NewFloat64Value(*config.Threshold)
 		}
 	default:
 		diags.AddError(
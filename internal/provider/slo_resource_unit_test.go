@@ -3,6 +3,8 @@ package provider
 import (
 	"context"
 	"terraform-provider-tsuga/internal/aggregate"
+	"terraform-provider-tsuga/internal/customtypes"
+	"terraform-provider-tsuga/internal/filterexpression"
 	"terraform-provider-tsuga/internal/groupby"
 	"terraform-provider-tsuga/internal/resource_monitor"
 	"terraform-provider-tsuga/internal/resource_slo"
@@ -19,7 +21,8 @@ func sloCountQueries(t *testing.T, filter string) types.List {
 	elemType := types.ObjectType{AttrTypes: resource_monitor.QueryAttrTypes()}
 	list, diags := types.ListValueFrom(context.Background(), elemType, []resource_monitor.MonitorQueryModel{
 		{
-			Filter: types.StringValue(filter),
+			Filter:           customtypes.NewQueryValue(filter),
+			FilterExpression: filterexpression.Null(),
 			Aggregate: resource_monitor.MonitorAggregateModel{
 				Count: &aggregate.CountModel{Field: types.StringNull()},
 			},
@@ -34,8 +37,8 @@ func sloCountQueries(t *testing.T, filter string) types.List {
 
 func TestExpandSloAlertConfiguration_BurnRate(t *testing.T) {
 	expanded, diags := expandSloAlertConfiguration(resource_slo.SloAlertConfigurationModel{
-		BurnRate:  types.Float64Value(14.4),
-		Threshold: types.Float64Null(),
+		BurnRate:  customtypes.NewFloat64Value(14.4),
+		Threshold: customtypes.NewFloat64Null(),
 	}, "alerts[0].configuration")
 	if diags.HasError() {
 		t.Fatalf("unexpected diagnostics: %v", diags)
@@ -53,8 +56,8 @@ func TestExpandSloAlertConfiguration_BurnRate(t *testing.T) {
 
 func TestExpandSloAlertConfiguration_Threshold(t *testing.T) {
 	expanded, diags := expandSloAlertConfiguration(resource_slo.SloAlertConfigurationModel{
-		BurnRate:  types.Float64Null(),
-		Threshold: types.Float64Value(99.0),
+		BurnRate:  customtypes.NewFloat64Null(),
+		Threshold: customtypes.NewFloat64Value(99.0),
 	}, "alerts[0].configuration")
 	if diags.HasError() {
 		t.Fatalf("unexpected diagnostics: %v", diags)
@@ -69,8 +72,8 @@ func TestExpandSloAlertConfiguration_Threshold(t *testing.T) {
 
 func TestExpandSloAlertConfiguration_BothSetErrors(t *testing.T) {
 	_, diags := expandSloAlertConfiguration(resource_slo.SloAlertConfigurationModel{
-		BurnRate:  types.Float64Value(14.4),
-		Threshold: types.Float64Value(99.0),
+		BurnRate:  customtypes.NewFloat64Value(14.4),
+		Threshold: customtypes.NewFloat64Value(99.0),
 	}, "alerts[0].configuration")
 	if !diags.HasError() {
 		t.Fatal("expected an error when both burn_rate and threshold are set")
@@ -79,8 +82,8 @@ func TestExpandSloAlertConfiguration_BothSetErrors(t *testing.T) {
 
 func TestExpandSloAlertConfiguration_NoneSetErrors(t *testing.T) {
 	_, diags := expandSloAlertConfiguration(resource_slo.SloAlertConfigurationModel{
-		BurnRate:  types.Float64Null(),
-		Threshold: types.Float64Null(),
+		BurnRate:  customtypes.NewFloat64Null(),
+		Threshold: customtypes.NewFloat64Null(),
 	}, "alerts[0].configuration")
 	if !diags.HasError() {
 		t.Fatal("expected an error when neither burn_rate nor threshold is set")
@@ -126,13 +129,13 @@ func TestExpandSloAlerts_MatchesPriorIdsByContent(t *testing.T) {
 	ctx := context.Background()
 	// Prior state has two alerts with server ids.
 	prior := []resource_slo.SloAlertModel{
-		sloAlertRefEntry("alert-1", 1, types.Float64Value(14.4), types.Float64Null()),
-		sloAlertRefEntry("alert-2", 3, types.Float64Null(), types.Float64Value(99.0)),
+		sloAlertRefEntry("alert-1", 1, customtypes.NewFloat64Value(14.4), customtypes.NewFloat64Null()),
+		sloAlertRefEntry("alert-2", 3, customtypes.NewFloat64Null(), customtypes.NewFloat64Value(99.0)),
 	}
 	// Plan keeps the burn-rate alert unchanged (reuse alert-1) and edits the threshold alert's value
 	alerts := sloAlertList(t,
-		sloAlertRefEntry("", 1, types.Float64Value(14.4), types.Float64Null()),
-		sloAlertRefEntry("", 4, types.Float64Null(), types.Float64Value(95.0)),
+		sloAlertRefEntry("", 1, customtypes.NewFloat64Value(14.4), customtypes.NewFloat64Null()),
+		sloAlertRefEntry("", 4, customtypes.NewFloat64Null(), customtypes.NewFloat64Value(95.0)),
 	)
 
 	r := &sloResource{}
@@ -151,16 +154,48 @@ func TestExpandSloAlerts_MatchesPriorIdsByContent(t *testing.T) {
 	}
 }
 
+func TestPlanSloAlertIDs_MatchesPriorIdsByContent(t *testing.T) {
+	ctx := context.Background()
+	prior := []resource_slo.SloAlertModel{
+		sloAlertRefEntry("alert-1", 1, customtypes.NewFloat64Value(14.4), customtypes.NewFloat64Null()),
+		sloAlertRefEntry("alert-2", 3, customtypes.NewFloat64Null(), customtypes.NewFloat64Value(99.0)),
+	}
+	// The plan inserts a new alert first and swaps the two existing ones.
+	planned := []resource_slo.SloAlertModel{
+		sloAlertRefEntry("", 4, customtypes.NewFloat64Null(), customtypes.NewFloat64Value(95.0)),
+		sloAlertRefEntry("", 3, customtypes.NewFloat64Null(), customtypes.NewFloat64Value(99.0)),
+		sloAlertRefEntry("", 1, customtypes.NewFloat64Value(14.4), customtypes.NewFloat64Null()),
+	}
+	for i := range planned {
+		planned[i].Id = types.StringUnknown()
+	}
+
+	list, diags := planSloAlertIDs(ctx, sloAlertList(t, planned...), prior)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	var got []resource_slo.SloAlertModel
+	if diags := list.ElementsAs(ctx, &got, false); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !got[0].Id.IsUnknown() {
+		t.Errorf("expected the new alert's id to stay unknown, got %s", got[0].Id)
+	}
+	if got[1].Id.ValueString() != "alert-2" || got[2].Id.ValueString() != "alert-1" {
+		t.Errorf("expected the moved alerts to keep their ids [alert-2, alert-1], got [%s, %s]", got[1].Id, got[2].Id)
+	}
+}
+
 func TestExpandSloAlerts_RemovePreservesRemainingAlertId(t *testing.T) {
 	ctx := context.Background()
 	// Prior: A(id1, burn-rate) then B(id2, threshold).
 	prior := []resource_slo.SloAlertModel{
-		sloAlertRefEntry("id1", 1, types.Float64Value(14.4), types.Float64Null()),
-		sloAlertRefEntry("id2", 3, types.Float64Null(), types.Float64Value(99.0)),
+		sloAlertRefEntry("id1", 1, customtypes.NewFloat64Value(14.4), customtypes.NewFloat64Null()),
+		sloAlertRefEntry("id2", 3, customtypes.NewFloat64Null(), customtypes.NewFloat64Value(99.0)),
 	}
 	// Remove A, keep B unchanged. B must keep id2 — not inherit A's id1 by position.
 	alerts := sloAlertList(t,
-		sloAlertRefEntry("", 3, types.Float64Null(), types.Float64Value(99.0)),
+		sloAlertRefEntry("", 3, customtypes.NewFloat64Null(), customtypes.NewFloat64Value(99.0)),
 	)
 
 	r := &sloResource{}
@@ -181,12 +216,12 @@ func TestExpandSloAlerts_DuplicatesPreserveBothIds(t *testing.T) {
 	// Two prior alerts with identical content but distinct ids. Two identical planned alerts must
 	// reuse both prior ids, one each (claim-once), not double up on one.
 	prior := []resource_slo.SloAlertModel{
-		sloAlertRefEntry("id1", 3, types.Float64Null(), types.Float64Value(99.0)),
-		sloAlertRefEntry("id2", 3, types.Float64Null(), types.Float64Value(99.0)),
+		sloAlertRefEntry("id1", 3, customtypes.NewFloat64Null(), customtypes.NewFloat64Value(99.0)),
+		sloAlertRefEntry("id2", 3, customtypes.NewFloat64Null(), customtypes.NewFloat64Value(99.0)),
 	}
 	alerts := sloAlertList(t,
-		sloAlertRefEntry("", 3, types.Float64Null(), types.Float64Value(99.0)),
-		sloAlertRefEntry("", 3, types.Float64Null(), types.Float64Value(99.0)),
+		sloAlertRefEntry("", 3, customtypes.NewFloat64Null(), customtypes.NewFloat64Value(99.0)),
+		sloAlertRefEntry("", 3, customtypes.NewFloat64Null(), customtypes.NewFloat64Value(99.0)),
 	)
 
 	r := &sloResource{}
@@ -208,12 +243,12 @@ func TestExpandSloAlerts_ReorderMatchesByContent(t *testing.T) {
 	// Prior [A(id-a, burn), B(id-b, threshold)] reversed in the plan to [B, A]. Each planned alert
 	// reuses the id of the prior alert with matching content, not the id at its position.
 	prior := []resource_slo.SloAlertModel{
-		sloAlertRefEntry("id-a", 1, types.Float64Value(14.4), types.Float64Null()),
-		sloAlertRefEntry("id-b", 3, types.Float64Null(), types.Float64Value(99.0)),
+		sloAlertRefEntry("id-a", 1, customtypes.NewFloat64Value(14.4), customtypes.NewFloat64Null()),
+		sloAlertRefEntry("id-b", 3, customtypes.NewFloat64Null(), customtypes.NewFloat64Value(99.0)),
 	}
 	alerts := sloAlertList(t,
-		sloAlertRefEntry("", 3, types.Float64Null(), types.Float64Value(99.0)),
-		sloAlertRefEntry("", 1, types.Float64Value(14.4), types.Float64Null()),
+		sloAlertRefEntry("", 3, customtypes.NewFloat64Null(), customtypes.NewFloat64Value(99.0)),
+		sloAlertRefEntry("", 1, customtypes.NewFloat64Value(14.4), customtypes.NewFloat64Null()),
 	)
 
 	r := &sloResource{}
@@ -235,8 +270,8 @@ func TestOrderSloAlerts_DuplicatesStableById(t *testing.T) {
 		{ID: "id1", Priority: 3, Configuration: sloAPIAlertConfiguration{Type: "threshold", Threshold: &threshold}},
 	}
 	ref := []resource_slo.SloAlertModel{
-		sloAlertRefEntry("id1", 3, types.Float64Null(), types.Float64Value(99.0)),
-		sloAlertRefEntry("id2", 3, types.Float64Null(), types.Float64Value(99.0)),
+		sloAlertRefEntry("id1", 3, customtypes.NewFloat64Null(), customtypes.NewFloat64Value(99.0)),
+		sloAlertRefEntry("id2", 3, customtypes.NewFloat64Null(), customtypes.NewFloat64Value(99.0)),
 	}
 
 	ordered := orderSloAlerts(alerts, ref)
@@ -245,7 +280,7 @@ func TestOrderSloAlerts_DuplicatesStableById(t *testing.T) {
 	}
 }
 
-func sloAlertRefEntry(id string, priority int64, burnRate, threshold types.Float64) resource_slo.SloAlertModel {
+func sloAlertRefEntry(id string, priority int64, burnRate, threshold customtypes.Float64Value) resource_slo.SloAlertModel {
 	idVal := types.StringValue(id)
 	if id == "" {
 		idVal = types.StringNull()
@@ -271,8 +306,8 @@ func TestFlattenSloAlerts_ReordersToReferenceById(t *testing.T) {
 	}
 	// ...but the reference (plan/state) wants them reversed.
 	ref := []resource_slo.SloAlertModel{
-		sloAlertRefEntry("alert-2", 3, types.Float64Null(), types.Float64Value(99.0)),
-		sloAlertRefEntry("alert-1", 1, types.Float64Value(14.4), types.Float64Null()),
+		sloAlertRefEntry("alert-2", 3, customtypes.NewFloat64Null(), customtypes.NewFloat64Value(99.0)),
+		sloAlertRefEntry("alert-1", 1, customtypes.NewFloat64Value(14.4), customtypes.NewFloat64Null()),
 	}
 
 	list, diags := flattenSloAlerts(alerts, ref)
@@ -311,7 +346,7 @@ func TestFlattenSloAlerts_MatchesNewAlertByContentThenAppendsLeftovers(t *testin
 	}
 	// Reference has one entry with no id yet, matched by content (priority 2, burn-rate 6).
 	ref := []resource_slo.SloAlertModel{
-		sloAlertRefEntry("", 2, types.Float64Value(6.0), types.Float64Null()),
+		sloAlertRefEntry("", 2, customtypes.NewFloat64Value(6.0), customtypes.NewFloat64Null()),
 	}
 
 	list, diags := flattenSloAlerts(alerts, ref)
@@ -521,7 +556,7 @@ func TestExpandSloTimeConfiguration_BuildsThreshold(t *testing.T) {
 		SliceSizeMinutes: types.Int64Value(5),
 		Threshold: resource_slo.SloTimeThresholdModel{
 			Operator: types.StringValue("less_than"),
-			Value:    types.Float64Value(300),
+			Value:    customtypes.NewFloat64Value(300),
 		},
 		GroupByFields:  types.ListNull(groupByElemType),
 		NoDataBehavior: types.StringValue("ignore"),
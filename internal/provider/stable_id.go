@@ -0,0 +1,16 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+// withStableID keeps the computed `id` of a generated resource schema known in
+// update plans, instead of showing it as known after apply. Hand-written schemas
+// declare the plan modifier themselves.
+func withStableID(s schema.Schema) schema.Schema {
+	id := s.Attributes["id"].(schema.StringAttribute)
+	id.PlanModifiers = append(id.PlanModifiers, stringplanmodifier.UseStateForUnknown())
+	s.Attributes["id"] = id
+	return s
+}
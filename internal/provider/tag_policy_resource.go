@@ -49,8 +49,8 @@ func (r *tagPolicyResource) Metadata(_ context.Context, req resource.MetadataReq
 	resp.TypeName = req.ProviderTypeName + "_tag_policy"
 }
 
-func (r *tagPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = resource_tag_policy.TagPolicyResourceSchema()
+func (r *tagPolicyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = withTimeouts(ctx, withOnDestroy(resource_tag_policy.TagPolicyResourceSchema(), "is_active"))
 }
 
 func (r *tagPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -97,6 +97,15 @@ func (r *tagPolicyResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	requestBody, diags := r.buildTagPolicyRequestBody(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -109,6 +118,8 @@ func (r *tagPolicyResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	newState.OnDestroy = plan.OnDestroy
+	newState.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
 }
 
@@ -120,6 +131,15 @@ func (r *tagPolicyResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	path := fmt.Sprintf("/v1/tag-policies/%s", state.Id.ValueString())
 	httpResp, err := r.client.doRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -156,6 +176,8 @@ func (r *tagPolicyResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	newState.OnDestroy = state.OnDestroy
+	newState.Timeouts = state.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
 }
 
@@ -169,6 +191,15 @@ func (r *tagPolicyResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	requestBody, diags := r.buildTagPolicyRequestBody(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -182,6 +213,8 @@ func (r *tagPolicyResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	newState.OnDestroy = plan.OnDestroy
+	newState.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
 }
 
@@ -269,7 +302,32 @@ func (r *tagPolicyResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	path := fmt.Sprintf("/v1/tag-policies/%s", state.Id.ValueString())
+
+	switch state.OnDestroy.ValueString() {
+	case onDestroyAbandon:
+		return
+	case onDestroyDisable:
+		state.IsActive = types.BoolValue(false)
+		requestBody, diags := r.buildTagPolicyRequestBody(ctx, state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(disableOnDestroy(ctx, r.client, path, requestBody, "tag policy")...)
+		return
+	}
+
 	httpResp, err := r.client.doRequest(ctx, http.MethodDelete, path, map[string]any{})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete tag policy: %s", err))
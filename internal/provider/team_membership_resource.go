@@ -49,7 +49,7 @@ func (r *teamMembershipResource) Metadata(_ context.Context, req resource.Metada
 }
 
 func (r *teamMembershipResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = resource_team_membership.TeamMembershipResourceSchema(ctx)
+	resp.Schema = withTimeouts(ctx, resource_team_membership.TeamMembershipResourceSchema(ctx))
 }
 
 // ImportState supports importing via "userId:teamId" composite.
@@ -79,6 +79,15 @@ func (r *teamMembershipResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	requestBody := map[string]interface{}{
 		"userId":  plan.UserId.ValueString(),
 		"teamId":  plan.TeamId.ValueString(),
@@ -115,6 +124,10 @@ func (r *teamMembershipResource) Create(ctx context.Context, req resource.Create
 	plan.RoleKey = types.StringValue(apiResp.Data.RoleKey)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	if err := r.waitForMembership(ctx, apiResp.Data); err != nil {
+		resp.Diagnostics.AddWarning("Consistency Warning", fmt.Sprintf("Team membership %s was created, but is not readable yet: %s.", apiResp.Data.ID, err))
+	}
 }
 
 func (r *teamMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -126,6 +139,15 @@ func (r *teamMembershipResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	apiPath := fmt.Sprintf("/v1/team-memberships?userId=%s&teamId=%s",
 		state.UserId.ValueString(),
 		state.TeamId.ValueString(),
@@ -184,6 +206,15 @@ func (r *teamMembershipResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	requestBody := map[string]interface{}{
 		"userId":  state.UserId.ValueString(),
 		"teamId":  state.TeamId.ValueString(),
@@ -220,6 +251,10 @@ func (r *teamMembershipResource) Update(ctx context.Context, req resource.Update
 	plan.RoleKey = types.StringValue(apiResp.Data.RoleKey)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	if err := r.waitForMembership(ctx, apiResp.Data); err != nil {
+		resp.Diagnostics.AddWarning("Consistency Warning", fmt.Sprintf("Team membership %s was updated, but reads do not return the update yet: %s.", apiResp.Data.ID, err))
+	}
 }
 
 func (r *teamMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -231,6 +266,15 @@ func (r *teamMembershipResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	requestBody := map[string]interface{}{
 		"userId": state.UserId.ValueString(),
 		"teamId": state.TeamId.ValueString(),
@@ -251,6 +295,23 @@ func (r *teamMembershipResource) Delete(ctx context.Context, req resource.Delete
 	}
 }
 
+// waitForMembership waits until reads of the membership, and of the team it
+// belongs to, return them as written.
+func (r *teamMembershipResource) waitForMembership(ctx context.Context, written teamMembershipData) error {
+	if err := r.client.waitForConsistency(ctx, fmt.Sprintf("/v1/teams/%s", written.TeamId), anyObject); err != nil {
+		return err
+	}
+
+	apiPath := fmt.Sprintf("/v1/team-memberships?userId=%s&teamId=%s", written.UserId, written.TeamId)
+	return r.client.waitForConsistency(ctx, apiPath, func(body []byte) (bool, error) {
+		var apiResp teamMembershipListAPIResponse
+		if err := json.Unmarshal(body, &apiResp); err != nil {
+			return false, err
+		}
+		return len(apiResp.Data) > 0 && apiResp.Data[0] == written, nil
+	})
+}
+
 type teamMembershipData struct {
 	ID      string `json:"id"`
 	UserId  string `json:"userId"`
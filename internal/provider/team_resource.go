@@ -6,9 +6,11 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"slices"
 
 	"terraform-provider-tsuga/internal/resource_team"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -17,6 +19,7 @@ import (
 var _ resource.Resource = (*teamResource)(nil)
 var _ resource.ResourceWithConfigure = (*teamResource)(nil)
 var _ resource.ResourceWithImportState = (*teamResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*teamResource)(nil)
 
 func NewTeamResource() resource.Resource {
 	return &teamResource{}
@@ -26,6 +29,14 @@ type teamResource struct {
 	client *TsugaClient
 }
 
+// teamResourceModel adds `deletion_protection` and the `timeouts` block to the
+// generated team model.
+type teamResourceModel struct {
+	resource_team.TeamModel
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (r *teamResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -48,15 +59,19 @@ func (r *teamResource) Metadata(_ context.Context, req resource.MetadataRequest,
 }
 
 func (r *teamResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = resource_team.TeamResourceSchema(ctx)
+	resp.Schema = withTimeouts(ctx, withDeletionProtection(resource_team.TeamResourceSchema(ctx)))
 }
 
 func (r *teamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *teamResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnDeletionProtection(ctx, "team", req, resp)
+}
+
 func (r *teamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan resource_team.TeamModel
+	var plan teamResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
@@ -64,6 +79,15 @@ func (r *teamResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	requestBody := map[string]interface{}{
 		"name":       plan.Name.ValueString(),
 		"visibility": plan.Visibility.ValueString(),
@@ -123,10 +147,14 @@ func (r *teamResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	if err := r.waitForTeam(ctx, apiResp); err != nil {
+		resp.Diagnostics.AddWarning("Consistency Warning", fmt.Sprintf("Team %s was created, but is not readable yet: %s. Resources referencing it may fail until it is.", apiResp.Data.ID, err))
+	}
 }
 
 func (r *teamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state resource_team.TeamModel
+	var state teamResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
@@ -134,6 +162,15 @@ func (r *teamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	path := fmt.Sprintf("/v1/teams/%s", state.Id.ValueString())
 	httpResp, err := r.client.doRequest(ctx, http.MethodGet, path, nil)
 	if err != nil {
@@ -186,8 +223,8 @@ func (r *teamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 func (r *teamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan resource_team.TeamModel
-	var state resource_team.TeamModel
+	var plan teamResourceModel
+	var state teamResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -196,6 +233,15 @@ func (r *teamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	requestBody := map[string]interface{}{
 		"name":       plan.Name.ValueString(),
 		"visibility": plan.Visibility.ValueString(),
@@ -255,10 +301,14 @@ func (r *teamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	if err := r.waitForTeam(ctx, apiResp); err != nil {
+		resp.Diagnostics.AddWarning("Consistency Warning", fmt.Sprintf("Team %s was updated, but reads do not return the update yet: %s.", apiResp.Data.ID, err))
+	}
 }
 
 func (r *teamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state resource_team.TeamModel
+	var state teamResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
@@ -266,6 +316,20 @@ func (r *teamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.Append(deletionProtectionError("team", state.Id))
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	path := fmt.Sprintf("/v1/teams/%s", state.Id.ValueString())
 	httpResp, err := r.client.doRequest(ctx, http.MethodDelete, path, map[string]interface{}{})
 	if err != nil {
@@ -291,3 +355,20 @@ type teamAPIResponse struct {
 		Tags        []apiTag `json:"tags"`
 	} `json:"data"`
 }
+
+// waitForTeam waits until reads of the team return it as written.
+func (r *teamResource) waitForTeam(ctx context.Context, written teamAPIResponse) error {
+	path := fmt.Sprintf("/v1/teams/%s", written.Data.ID)
+	return r.client.waitForConsistency(ctx, path, func(body []byte) (bool, error) {
+		var apiResp teamAPIResponse
+		if err := json.Unmarshal(body, &apiResp); err != nil {
+			return false, err
+		}
+		got, want := apiResp.Data, written.Data
+		return got.ID == want.ID &&
+			got.Name == want.Name &&
+			got.Description == want.Description &&
+			got.Visibility == want.Visibility &&
+			slices.Equal(got.Tags, want.Tags), nil
+	})
+}
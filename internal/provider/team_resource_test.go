@@ -3,6 +3,7 @@ package provider
 import (
 	"fmt"
 	"math/rand"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/compare"
@@ -77,6 +78,64 @@ resource "tsuga_team" "test" {
 				},
 				Check: resource.TestCheckResourceAttr("tsuga_team.test", "description", "second"),
 			},
+			// Adding a timeouts block only changes state, in place.
+			{
+				Config: providerConfig + fmt.Sprintf(`
+resource "tsuga_team" "test" {
+  name = "%s"
+  visibility = "private"
+  description = "second"
+
+  timeouts {
+    create = "2m"
+    update = "2m"
+  }
+}
+`, teamName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("tsuga_team.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					teamID.AddStateValue("tsuga_team.test", tfjsonpath.New("id")),
+				},
+				Check: resource.TestCheckResourceAttr("tsuga_team.test", "timeouts.update", "2m"),
+			},
+		},
+	})
+}
+
+func TestAccTeamResource_deletionProtection(t *testing.T) {
+	teamName := fmt.Sprintf("test-%s", randomString(10))
+	config := func(protected bool) string {
+		return providerConfig + fmt.Sprintf(`
+resource "tsuga_team" "test" {
+  name                = "%s"
+  visibility          = "public"
+  deletion_protection = %t
+}
+`, teamName, protected)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(true),
+				Check:  resource.TestCheckResourceAttr("tsuga_team.test", "deletion_protection", "true"),
+			},
+			// Destroying the protected team fails and leaves it in place.
+			{
+				Config:      config(true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Deletion Protection Enabled"),
+			},
+			// Lifting the protection lets the post-test destroy through.
+			{
+				Config: config(false),
+				Check:  resource.TestCheckResourceAttr("tsuga_team.test", "deletion_protection", "false"),
+			},
 		},
 	})
 }
@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// Default durations of resource operations, used when the `timeouts` block leaves
+// them unset. Each bounds a whole Create, Read, Update or Delete, every API request
+// it makes included.
+const (
+	defaultCreateTimeout = 5 * time.Minute
+	defaultReadTimeout   = 5 * time.Minute
+	defaultUpdateTimeout = 5 * time.Minute
+	defaultDeleteTimeout = 5 * time.Minute
+)
+
+// withTimeouts adds the `timeouts` block to a resource schema.
+func withTimeouts(ctx context.Context, s schema.Schema) schema.Schema {
+	if s.Blocks == nil {
+		s.Blocks = map[string]schema.Block{}
+	}
+	s.Blocks["timeouts"] = timeouts.Block(ctx, timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Update: true,
+		Delete: true,
+	})
+	return s
+}
@@ -0,0 +1,68 @@
+package query
+
+// Node is a node of a parsed query: one of *MatchAll, *And, *Or, *Not or *Term.
+// Offsets are byte offsets in the query source.
+type Node any
+
+// MatchAll matches every event. It is produced for an empty query and for the
+// bare `*` and `true` queries.
+type MatchAll struct {
+	Offset int
+}
+
+// And matches when every operand matches. Juxtaposed terms (`a b`) are an implicit AND.
+type And struct {
+	Operands []Node
+}
+
+// Or matches when at least one operand matches.
+type Or struct {
+	Operands []Node
+}
+
+// Not negates its operand. Both `NOT a` and `-a` produce it.
+type Not struct {
+	Offset  int
+	Operand Node
+}
+
+// Term compares an attribute with a value. Field is empty for free-text terms, which
+// search the log message.
+type Term struct {
+	Offset int
+	Field  string
+	Value  Value
+}
+
+// ValueKind selects how a Term value is compared.
+type ValueKind int
+
+const (
+	// ValueExact compares for equality, case-insensitively for strings.
+	ValueExact ValueKind = iota
+	// ValueWildcard matches a pattern where `*` is any sequence and `?` one character.
+	ValueWildcard
+	// ValueExists matches when the attribute is present (`field:*`).
+	ValueExists
+	// ValueCompare compares with Op (>, >=, < or <=).
+	ValueCompare
+	// ValueRange matches values between Low and High. `*` leaves a bound open.
+	ValueRange
+)
+
+// Value is the right-hand side of a Term.
+type Value struct {
+	Kind ValueKind
+	// Text is the unescaped value for ValueExact and ValueCompare.
+	Text string
+	// Pattern is the wildcard pattern for ValueWildcard, with escaped characters
+	// prefixed by a backslash.
+	Pattern string
+	// Quoted reports whether the value was written as a quoted phrase.
+	Quoted bool
+	Op     string
+
+	Low, High               string
+	IncludeLow, IncludeHigh bool
+	OpenLow, OpenHigh       bool
+}
@@ -0,0 +1,198 @@
+package query
+
+import (
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokWord
+	tokQuoted
+	tokLParen
+	tokRParen
+	tokColon
+	tokCompare
+	tokLBracket
+	tokRBracket
+	tokAnd
+	tokOr
+	tokNot
+	tokMinus
+)
+
+func (k tokenKind) String() string {
+	switch k {
+	case tokEOF:
+		return "end of query"
+	case tokWord, tokQuoted:
+		return "value"
+	case tokLParen:
+		return `"("`
+	case tokRParen:
+		return `")"`
+	case tokColon:
+		return `":"`
+	case tokCompare:
+		return "comparison operator"
+	case tokLBracket:
+		return "range start"
+	case tokRBracket:
+		return "range end"
+	case tokAnd:
+		return "AND"
+	case tokOr:
+		return "OR"
+	case tokNot, tokMinus:
+		return "NOT"
+	default:
+		return "token"
+	}
+}
+
+// token is a lexical unit of a query. Pos is the byte offset of its first character.
+// For words, Text holds the unescaped value and Raw the source text, so wildcards can be
+// told apart from escaped literal asterisks.
+type token struct {
+	kind tokenKind
+	text string
+	raw  string
+	pos  int
+}
+
+// lex splits src into tokens. Keywords (AND, OR, NOT, TO) are only recognised in upper
+// case, matching the query language, so that lowercase words stay free text.
+func lex(src string) ([]token, error) {
+	var tokens []token
+	i := 0
+	for i < len(src) {
+		c := src[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			tokens = append(tokens, token{kind: tokLParen, text: "(", raw: "(", pos: i})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{kind: tokRParen, text: ")", raw: ")", pos: i})
+			i++
+		case c == '[' || c == '{':
+			tokens = append(tokens, token{kind: tokLBracket, text: string(c), raw: string(c), pos: i})
+			i++
+		case c == ']' || c == '}':
+			tokens = append(tokens, token{kind: tokRBracket, text: string(c), raw: string(c), pos: i})
+			i++
+		case c == ':':
+			tokens = append(tokens, token{kind: tokColon, text: ":", raw: ":", pos: i})
+			i++
+		case (c == '>' || c == '<') && lastKind(tokens) == tokColon:
+			op := string(c)
+			if i+1 < len(src) && src[i+1] == '=' {
+				op += "="
+			}
+			tokens = append(tokens, token{kind: tokCompare, text: op, raw: op, pos: i})
+			i += len(op)
+		case c == '-' && startsTerm(tokens) && i+1 < len(src) && !isDelimiter(src[i+1]):
+			tokens = append(tokens, token{kind: tokMinus, text: "-", raw: "-", pos: i})
+			i++
+		case c == '"':
+			text, end, err := lexQuoted(src, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{kind: tokQuoted, text: text, raw: src[i:end], pos: i})
+			i = end
+		default:
+			// The value of a field:value term may itself hold colons, as in
+			// URLs, IPv6 addresses and times.
+			value := lastKind(tokens) == tokColon || lastKind(tokens) == tokCompare
+			text, end, err := lexWord(src, i, value)
+			if err != nil {
+				return nil, err
+			}
+			tok := token{kind: tokWord, text: text, raw: src[i:end], pos: i}
+			switch tok.raw {
+			case "AND", "&&":
+				tok.kind = tokAnd
+			case "OR", "||":
+				tok.kind = tokOr
+			case "NOT":
+				tok.kind = tokNot
+			}
+			tokens = append(tokens, tok)
+			i = end
+		}
+	}
+	tokens = append(tokens, token{kind: tokEOF, pos: len(src)})
+	return tokens, nil
+}
+
+func lastKind(tokens []token) tokenKind {
+	if len(tokens) == 0 {
+		return tokEOF
+	}
+	return tokens[len(tokens)-1].kind
+}
+
+// startsTerm reports whether the next token begins a new term, in which case a leading
+// "-" negates it rather than being part of a value such as status_code:-1.
+func startsTerm(tokens []token) bool {
+	switch lastKind(tokens) {
+	case tokColon, tokCompare, tokLBracket:
+		return false
+	case tokWord:
+		// A range bound such as [-5 TO -1] follows the TO keyword.
+		return tokens[len(tokens)-1].raw != "TO"
+	default:
+		return true
+	}
+}
+
+func isDelimiter(c byte) bool {
+	switch c {
+	case ' ', '\t', '\n', '\r', '(', ')', ':', '"', '[', ']', '{', '}':
+		return true
+	}
+	return false
+}
+
+func lexQuoted(src string, start int) (string, int, error) {
+	var b strings.Builder
+	i := start + 1
+	for i < len(src) {
+		switch src[i] {
+		case '\\':
+			if i+1 >= len(src) {
+				return "", 0, &SyntaxError{Pos: i, Msg: "escape character at end of query"}
+			}
+			b.WriteByte(src[i+1])
+			i += 2
+		case '"':
+			return b.String(), i + 1, nil
+		default:
+			b.WriteByte(src[i])
+			i++
+		}
+	}
+	return "", 0, &SyntaxError{Pos: start, Msg: "unterminated quoted string"}
+}
+
+// lexWord reads an unquoted word. When value is set, colons are part of the word.
+func lexWord(src string, start int, value bool) (string, int, error) {
+	var b strings.Builder
+	i := start
+	for i < len(src) && (!isDelimiter(src[i]) || (value && src[i] == ':')) {
+		if src[i] == '\\' {
+			if i+1 >= len(src) {
+				return "", 0, &SyntaxError{Pos: i, Msg: "escape character at end of query"}
+			}
+			b.WriteByte(src[i+1])
+			i += 2
+			continue
+		}
+		b.WriteByte(src[i])
+		i++
+	}
+	return b.String(), i, nil
+}
@@ -0,0 +1,226 @@
+package query
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MessageField is the attribute searched by free-text terms.
+const MessageField = "message"
+
+// Lookup resolves an attribute of the event being matched.
+type Lookup func(field string) (any, bool)
+
+// Match reports whether the event exposed by get satisfies the query.
+//
+// String comparisons are case-insensitive. When an attribute holds a list, a term
+// matches if any element matches. Free-text terms match when the message contains the
+// value.
+func (q *Query) Match(get Lookup) bool {
+	return matchNode(q.Root, get)
+}
+
+func matchNode(n Node, get Lookup) bool {
+	switch n := n.(type) {
+	case *MatchAll:
+		return true
+	case *And:
+		for _, operand := range n.Operands {
+			if !matchNode(operand, get) {
+				return false
+			}
+		}
+		return true
+	case *Or:
+		for _, operand := range n.Operands {
+			if matchNode(operand, get) {
+				return true
+			}
+		}
+		return false
+	case *Not:
+		return !matchNode(n.Operand, get)
+	case *Term:
+		return matchTerm(n, get)
+	default:
+		return false
+	}
+}
+
+func matchTerm(t *Term, get Lookup) bool {
+	if t.Field == "" {
+		msg, ok := get(MessageField)
+		if !ok {
+			return false
+		}
+		text := strings.ToLower(stringify(msg))
+		switch t.Value.Kind {
+		case ValueWildcard:
+			return matchWildcard("*"+t.Value.Pattern+"*", text)
+		default:
+			return strings.Contains(text, strings.ToLower(t.Value.Text))
+		}
+	}
+
+	v, ok := get(t.Field)
+	if !ok || v == nil {
+		return false
+	}
+	if t.Value.Kind == ValueExists {
+		return true
+	}
+	if list, isList := v.([]any); isList {
+		for _, el := range list {
+			if matchValue(t.Value, el) {
+				return true
+			}
+		}
+		return false
+	}
+	return matchValue(t.Value, v)
+}
+
+func matchValue(want Value, v any) bool {
+	switch want.Kind {
+	case ValueExact:
+		if n, ok := toNumber(v); ok {
+			if w, err := strconv.ParseFloat(want.Text, 64); err == nil {
+				return n == w
+			}
+		}
+		return strings.EqualFold(stringify(v), want.Text)
+	case ValueWildcard:
+		return matchWildcard(want.Pattern, strings.ToLower(stringify(v)))
+	case ValueCompare:
+		c, ok := compare(v, want.Text)
+		if !ok {
+			return false
+		}
+		switch want.Op {
+		case ">":
+			return c > 0
+		case ">=":
+			return c >= 0
+		case "<":
+			return c < 0
+		default:
+			return c <= 0
+		}
+	case ValueRange:
+		if !want.OpenLow {
+			c, ok := compare(v, want.Low)
+			if !ok || c < 0 || (c == 0 && !want.IncludeLow) {
+				return false
+			}
+		}
+		if !want.OpenHigh {
+			c, ok := compare(v, want.High)
+			if !ok || c > 0 || (c == 0 && !want.IncludeHigh) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+// compare orders v against text, numerically when both are numbers and lexically
+// otherwise. It returns false when the values cannot be ordered.
+func compare(v any, text string) (int, bool) {
+	if n, ok := toNumber(v); ok {
+		w, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return 0, false
+		}
+		switch {
+		case n < w:
+			return -1, true
+		case n > w:
+			return 1, true
+		default:
+			return 0, true
+		}
+	}
+	s, isString := v.(string)
+	if !isString {
+		return 0, false
+	}
+	return strings.Compare(strings.ToLower(s), strings.ToLower(text)), true
+}
+
+func toNumber(v any) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int64:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	case string:
+		f, err := strconv.ParseFloat(n, 64)
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+func stringify(v any) string {
+	switch s := v.(type) {
+	case string:
+		return s
+	case map[string]any, []any:
+		encoded, _ := json.Marshal(s)
+		return string(encoded)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// matchWildcard matches lowercased text against pattern, where `*` matches any
+// sequence, `?` a single character and a backslash escapes the next character.
+func matchWildcard(pattern, text string) bool {
+	p := []rune(strings.ToLower(pattern))
+	t := []rune(text)
+
+	// Classic iterative glob matching with backtracking to the last star.
+	pi, ti := 0, 0
+	starP, starT := -1, 0
+	for ti < len(t) {
+		if pi < len(p) {
+			switch {
+			case p[pi] == '*':
+				starP, starT = pi, ti
+				pi++
+				continue
+			case p[pi] == '?':
+				pi++
+				ti++
+				continue
+			case p[pi] == '\\' && pi+1 < len(p) && p[pi+1] == t[ti]:
+				pi += 2
+				ti++
+				continue
+			case p[pi] != '\\' && p[pi] == t[ti]:
+				pi++
+				ti++
+				continue
+			}
+		}
+		if starP < 0 {
+			return false
+		}
+		pi = starP + 1
+		starT++
+		ti = starT
+	}
+	for pi < len(p) && p[pi] == '*' {
+		pi++
+	}
+	return pi == len(p)
+}
@@ -0,0 +1,318 @@
+// Package query implements the Tsuga search syntax used by filters and queries across
+// monitors, dashboards, routes and notifications. It provides a lexer, a parser
+// producing an AST, positioned syntax errors for plan-time validation, and an
+// evaluator used by the offline route simulator.
+package query
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// SyntaxError reports a problem in a query. Pos is the byte offset of the offending
+// token; Line and Column locate it for humans, both starting at 1, with columns
+// counted in characters.
+type SyntaxError struct {
+	Pos    int
+	Line   int
+	Column int
+	Msg    string
+}
+
+func (e *SyntaxError) Error() string {
+	if e.Line > 1 {
+		return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Column, e.Msg)
+	}
+	return fmt.Sprintf("column %d: %s", e.Column, e.Msg)
+}
+
+// locate fills Line and Column from Pos.
+func (e *SyntaxError) locate(src string) {
+	before := src[:min(e.Pos, len(src))]
+	e.Line = strings.Count(before, "\n") + 1
+	e.Column = utf8.RuneCountInString(before[strings.LastIndexByte(before, '\n')+1:]) + 1
+}
+
+// Query is a parsed Tsuga query.
+type Query struct {
+	Root Node
+}
+
+// Parse parses a query written in the Tsuga search syntax:
+//
+//	service:api AND (status_code:>=500 OR level:error) -env:dev "timeout"
+//
+// OR binds looser than AND, and juxtaposed terms are combined with AND. Errors are
+// returned as *SyntaxError.
+func Parse(src string) (*Query, error) {
+	q, err := parse(src)
+	if err != nil {
+		var syntaxErr *SyntaxError
+		if errors.As(err, &syntaxErr) {
+			syntaxErr.locate(src)
+		}
+		return nil, err
+	}
+	return q, nil
+}
+
+func parse(src string) (*Query, error) {
+	tokens, err := lex(src)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	if p.peek().kind == tokEOF {
+		return &Query{Root: &MatchAll{}}, nil
+	}
+	root, err := p.parseOr("")
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokEOF {
+		return nil, unexpected(tok)
+	}
+	return &Query{Root: root}, nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func unexpected(tok token) error {
+	if tok.kind == tokEOF {
+		return &SyntaxError{Pos: tok.pos, Msg: "unexpected end of query"}
+	}
+	if tok.kind == tokWord || tok.kind == tokQuoted {
+		return &SyntaxError{Pos: tok.pos, Msg: fmt.Sprintf("unexpected value %s", tok.raw)}
+	}
+	return &SyntaxError{Pos: tok.pos, Msg: fmt.Sprintf("unexpected %s", tok.kind)}
+}
+
+// The parse functions take the field of an enclosing `field:( ... )` group, so that
+// bare values inside the group compare against it.
+
+func (p *parser) parseOr(field string) (Node, error) {
+	first, err := p.parseAnd(field)
+	if err != nil {
+		return nil, err
+	}
+	operands := []Node{first}
+	for p.peek().kind == tokOr {
+		p.next()
+		operand, err := p.parseAnd(field)
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, operand)
+	}
+	if len(operands) == 1 {
+		return first, nil
+	}
+	return &Or{Operands: operands}, nil
+}
+
+func (p *parser) parseAnd(field string) (Node, error) {
+	first, err := p.parseUnary(field)
+	if err != nil {
+		return nil, err
+	}
+	operands := []Node{first}
+	for {
+		switch p.peek().kind {
+		case tokAnd:
+			p.next()
+		case tokWord, tokQuoted, tokLParen, tokNot, tokMinus:
+			// Implicit AND between juxtaposed terms.
+		default:
+			if len(operands) == 1 {
+				return first, nil
+			}
+			return &And{Operands: operands}, nil
+		}
+		operand, err := p.parseUnary(field)
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, operand)
+	}
+}
+
+func (p *parser) parseUnary(field string) (Node, error) {
+	tok := p.peek()
+	if tok.kind == tokNot || tok.kind == tokMinus {
+		p.next()
+		operand, err := p.parseUnary(field)
+		if err != nil {
+			return nil, err
+		}
+		return &Not{Offset: tok.pos, Operand: operand}, nil
+	}
+	return p.parsePrimary(field)
+}
+
+func (p *parser) parsePrimary(field string) (Node, error) {
+	tok := p.next()
+	switch tok.kind {
+	case tokLParen:
+		return p.parseGroup(tok, field)
+	case tokQuoted:
+		return &Term{Offset: tok.pos, Field: field, Value: Value{Kind: ValueExact, Text: tok.text, Quoted: true}}, nil
+	case tokWord:
+		if p.peek().kind == tokColon {
+			if field != "" {
+				return nil, &SyntaxError{Pos: p.peek().pos, Msg: fmt.Sprintf("attribute %q cannot be used inside the value group of %q", tok.text, field)}
+			}
+			if tok.text == "" {
+				return nil, &SyntaxError{Pos: tok.pos, Msg: "missing attribute name"}
+			}
+			p.next()
+			return p.parseFieldValue(tok)
+		}
+		if field == "" && (tok.raw == "*" || tok.raw == "true") {
+			return &MatchAll{Offset: tok.pos}, nil
+		}
+		return &Term{Offset: tok.pos, Field: field, Value: wordValue(tok)}, nil
+	default:
+		return nil, unexpected(tok)
+	}
+}
+
+func (p *parser) parseGroup(open token, field string) (Node, error) {
+	if p.peek().kind == tokRParen {
+		return nil, &SyntaxError{Pos: open.pos, Msg: "empty group"}
+	}
+	inner, err := p.parseOr(field)
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().kind != tokRParen {
+		if p.peek().kind == tokEOF {
+			return nil, &SyntaxError{Pos: open.pos, Msg: `unclosed "("`}
+		}
+		return nil, unexpected(p.peek())
+	}
+	p.next()
+	return inner, nil
+}
+
+// parseFieldValue parses what follows `field:`.
+func (p *parser) parseFieldValue(fieldTok token) (Node, error) {
+	field := fieldTok.text
+	tok := p.next()
+	switch tok.kind {
+	case tokWord:
+		return &Term{Offset: fieldTok.pos, Field: field, Value: wordValue(tok)}, nil
+	case tokQuoted:
+		return &Term{Offset: fieldTok.pos, Field: field, Value: Value{Kind: ValueExact, Text: tok.text, Quoted: true}}, nil
+	case tokCompare:
+		operand := p.next()
+		if operand.kind != tokWord && operand.kind != tokQuoted {
+			return nil, &SyntaxError{Pos: operand.pos, Msg: fmt.Sprintf("missing value after %q", tok.text)}
+		}
+		return &Term{Offset: fieldTok.pos, Field: field, Value: Value{Kind: ValueCompare, Op: tok.text, Text: operand.text}}, nil
+	case tokLBracket:
+		value, err := p.parseRange(tok)
+		if err != nil {
+			return nil, err
+		}
+		return &Term{Offset: fieldTok.pos, Field: field, Value: value}, nil
+	case tokLParen:
+		return p.parseGroup(tok, field)
+	default:
+		if tok.kind == tokEOF || tok.kind == tokRParen || tok.kind == tokColon {
+			return nil, &SyntaxError{Pos: tok.pos, Msg: fmt.Sprintf("missing value for attribute %q", field)}
+		}
+		return nil, unexpected(tok)
+	}
+}
+
+// parseRange parses `[low TO high]`. Square brackets include the bound and curly
+// brackets exclude it; the two sides may be mixed.
+func (p *parser) parseRange(open token) (Value, error) {
+	value := Value{Kind: ValueRange, IncludeLow: open.text == "["}
+
+	low := p.next()
+	if low.kind != tokWord && low.kind != tokQuoted {
+		return value, &SyntaxError{Pos: low.pos, Msg: "missing lower bound in range"}
+	}
+	if to := p.next(); to.kind != tokWord || to.raw != "TO" {
+		return value, &SyntaxError{Pos: to.pos, Msg: `expected "TO" in range`}
+	}
+	high := p.next()
+	if high.kind != tokWord && high.kind != tokQuoted {
+		return value, &SyntaxError{Pos: high.pos, Msg: "missing upper bound in range"}
+	}
+	closing := p.next()
+	if closing.kind != tokRBracket {
+		return value, &SyntaxError{Pos: open.pos, Msg: "unclosed range"}
+	}
+
+	value.IncludeHigh = closing.text == "]"
+	value.Low, value.OpenLow = low.text, low.raw == "*"
+	value.High, value.OpenHigh = high.text, high.raw == "*"
+	return value, nil
+}
+
+// wordValue classifies an unquoted value. Unescaped `*` and `?` make it a wildcard.
+func wordValue(tok token) Value {
+	if tok.raw == "*" {
+		return Value{Kind: ValueExists}
+	}
+	pattern, wildcard := wildcardPattern(tok.raw)
+	if wildcard {
+		return Value{Kind: ValueWildcard, Pattern: pattern, Text: tok.text}
+	}
+	return Value{Kind: ValueExact, Text: tok.text}
+}
+
+// wildcardPattern reports whether raw holds unescaped wildcards and returns it with
+// escapes kept, for use by matchWildcard.
+func wildcardPattern(raw string) (string, bool) {
+	wildcard := false
+	for i := 0; i < len(raw); i++ {
+		switch raw[i] {
+		case '\\':
+			i++
+		case '*', '?':
+			wildcard = true
+		}
+	}
+	return raw, wildcard
+}
+
+// Equivalent reports whether a and b are the same query up to the whitespace
+// between tokens, which carries no meaning outside quoted strings. Queries that do
+// not lex are only equivalent when identical.
+func Equivalent(a, b string) bool {
+	if a == b {
+		return true
+	}
+	ta, errA := lex(a)
+	tb, errB := lex(b)
+	if errA != nil || errB != nil || len(ta) != len(tb) {
+		return false
+	}
+	for i := range ta {
+		if ta[i].kind != tb[i].kind || ta[i].raw != tb[i].raw {
+			return false
+		}
+	}
+	return true
+}
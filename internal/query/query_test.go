@@ -0,0 +1,153 @@
+package query
+
+import (
+	"errors"
+	"testing"
+)
+
+func lookupIn(event map[string]any) Lookup {
+	return func(field string) (any, bool) {
+		v, ok := event[field]
+		return v, ok
+	}
+}
+
+func TestParseAndMatch(t *testing.T) {
+	event := map[string]any{
+		"message":     "Connection timeout while calling payments",
+		"service":     "api",
+		"env":         "prod",
+		"status_code": float64(503),
+		"level":       "ERROR",
+		"tags":        []any{"team:core", "tier:1"},
+		"my-level":    "warn",
+		"url":         "http://example.com:8080/api",
+		"ip":          "2001:db8::1",
+		"time":        "12:30:00",
+	}
+
+	cases := []struct {
+		query string
+		want  bool
+	}{
+		{"", true},
+		{"*", true},
+		{"true", true},
+		{"service:api", true},
+		{"service:API", true},
+		{"service:web", false},
+		{"env:prod service:api", true},
+		{"service:api AND env:staging", false},
+		{"service:web OR env:prod", true},
+		{"status_code:>=500", true},
+		{"status_code:<500", false},
+		{"status_code:[500 TO 599]", true},
+		{"status_code:{503 TO 599]", false},
+		{"status_code:[500 TO *]", true},
+		{"status_code:503", true},
+		{"level:error", true},
+		{"scope.name:*", false},
+		{"service:*", true},
+		{"service:a*", true},
+		{"service:a?i", true},
+		{"service:(web OR api)", true},
+		{"service:(web OR worker)", false},
+		{"-env:dev", true},
+		{"NOT env:prod", false},
+		{"timeout", true},
+		{`"while calling"`, true},
+		{"time*", true},
+		{"missing", false},
+		{"tags:tier\\:1", true},
+		{"my-level:warn", true},
+		{"service:api AND (status_code:>=500 OR level:info) -env:dev", true},
+		{"service:web OR service:api AND env:dev", false},
+		{"url:http://example.com:8080/api", true},
+		{"url:http*", true},
+		{"ip:2001:db8::1", true},
+		{"ip:2001:db8::2", false},
+		{"time:>=12:00:00 time:<13:00", true},
+		{"time:12:30:00 service:api", true},
+	}
+
+	for _, tc := range cases {
+		q, err := Parse(tc.query)
+		if err != nil {
+			t.Errorf("Parse(%q): unexpected error: %v", tc.query, err)
+			continue
+		}
+		if got := q.Match(lookupIn(event)); got != tc.want {
+			t.Errorf("Parse(%q).Match() = %v, want %v", tc.query, got, tc.want)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	cases := []struct {
+		query string
+		pos   int
+	}{
+		{"service:", 8},
+		{"(service:api", 0},
+		{"service:api)", 11},
+		{"service:api AND", 15},
+		{`message:"unterminated`, 8},
+		{"status_code:>=", 14},
+		{"status_code:[1 500]", 15},
+		{"status_code:[1 TO 5", 12},
+		{"()", 0},
+		{"OR service:api", 0},
+		{"service:(env:prod)", 12},
+	}
+
+	for _, tc := range cases {
+		_, err := Parse(tc.query)
+		var syntaxErr *SyntaxError
+		if !errors.As(err, &syntaxErr) {
+			t.Errorf("Parse(%q): expected *SyntaxError, got %v", tc.query, err)
+			continue
+		}
+		if syntaxErr.Pos != tc.pos {
+			t.Errorf("Parse(%q): got error at %d (%s), want %d", tc.query, syntaxErr.Pos, syntaxErr.Msg, tc.pos)
+		}
+	}
+}
+
+func TestSyntaxErrorPosition(t *testing.T) {
+	_, err := Parse("service:api AND\n  env:prod )")
+	var syntaxErr *SyntaxError
+	if !errors.As(err, &syntaxErr) {
+		t.Fatalf("expected *SyntaxError, got %v", err)
+	}
+	if syntaxErr.Line != 2 || syntaxErr.Column != 12 {
+		t.Errorf("got line %d column %d, want line 2 column 12", syntaxErr.Line, syntaxErr.Column)
+	}
+	if want := `line 2, column 12: unexpected ")"`; err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+
+	_, err = Parse("café:(")
+	if !errors.As(err, &syntaxErr) || syntaxErr.Column != 7 {
+		t.Errorf("expected column counted in characters, got %v", err)
+	}
+}
+
+func TestEquivalent(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want bool
+	}{
+		{"service:api AND env:prod", "service:api AND env:prod", true},
+		{"service:api  AND\n\tenv:prod ", "service:api AND env:prod", true},
+		{"( level:error OR level:warn )", "(level:error OR level:warn)", true},
+		{`message:"connection  timeout"`, `message:"connection timeout"`, false},
+		{"service:api env:prod", "service:apienv:prod", false},
+		{"-env:dev", "- env:dev", false},
+		{`message:"open`, `message:"open `, false},
+	}
+	for _, tc := range cases {
+		if got := Equivalent(tc.a, tc.b); got != tc.want {
+			t.Errorf("Equivalent(%q, %q) = %v, want %v", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
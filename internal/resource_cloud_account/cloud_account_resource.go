@@ -1,6 +1,7 @@
 package resource_cloud_account
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -34,6 +35,10 @@ func CloudAccountResourceSchema() schema.Schema {
 				Optional:    true,
 				Description: "Human-readable name for the account shown in Tsuga.",
 			},
+			"adopt_existing": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether creating the resource takes over a cloud account already connected to Tsuga with the same cloud type and `cloud_account_id`, instead of failing. Its `account_friendly_name` is set to the configured one, but the API cannot update connection settings: the adopted account keeps the settings it was connected with, the configured `aws` or `gcp` settings are only recorded in state, and the apply warns about it. Defaults to `false`.",
+			},
 			"aws": schema.SingleNestedAttribute{
 				Optional:      true,
 				Description:   "AWS connection settings. Mutually exclusive with `gcp`. Immutable.",
@@ -81,8 +86,10 @@ type CloudAccountModel struct {
 	CloudType           types.String      `tfsdk:"cloud_type"`
 	CloudAccountId      types.String      `tfsdk:"cloud_account_id"`
 	AccountFriendlyName types.String      `tfsdk:"account_friendly_name"`
+	AdoptExisting       types.Bool        `tfsdk:"adopt_existing"`
 	Aws                 *AwsSettingsModel `tfsdk:"aws"`
 	Gcp                 *GcpSettingsModel `tfsdk:"gcp"`
+	Timeouts            timeouts.Value    `tfsdk:"timeouts"`
 }
 
 type AwsSettingsModel struct {
@@ -3,17 +3,24 @@ package resource_dashboard
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"terraform-provider-tsuga/internal/aggregate"
+	"terraform-provider-tsuga/internal/customtypes"
+	"terraform-provider-tsuga/internal/filterexpression"
 	"terraform-provider-tsuga/internal/groupby"
 	"terraform-provider-tsuga/internal/normalizer"
+	"terraform-provider-tsuga/internal/planmodifiers"
 	"terraform-provider-tsuga/internal/resource_team"
 )
 
@@ -24,6 +31,9 @@ func DashboardResourceSchema(ctx context.Context) schema.Schema {
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "Identifier of the dashboard",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
 				Required:    true,
@@ -228,13 +238,23 @@ func DashboardResourceSchema(ctx context.Context) schema.Schema {
 	}
 }
 
+// computedTypeSchema returns the schema for a visualization's `type`. The
+// provider sets it from the visualization block it sits in, so it never changes
+// once known and plans keep it from state.
+func computedTypeSchema() schema.Attribute {
+	return schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			planmodifiers.UseNonNullStateForUnknown(),
+		},
+	}
+}
+
 func visualizationSeriesSchema() schema.Attribute {
 	return schema.SingleNestedAttribute{
 		Optional: true,
 		Attributes: map[string]schema.Attribute{
-			"type": schema.StringAttribute{
-				Computed: true,
-			},
+			"type": computedTypeSchema(),
 			"source": schema.StringAttribute{
 				Required: true,
 				Validators: []validator.String{
@@ -322,9 +342,7 @@ func visualizationTableSchema() schema.Attribute {
 	return schema.SingleNestedAttribute{
 		Optional: true,
 		Attributes: map[string]schema.Attribute{
-			"type": schema.StringAttribute{
-				Computed: true,
-			},
+			"type": computedTypeSchema(),
 			"columns": schema.ListNestedAttribute{
 				Required: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -367,11 +385,14 @@ func queriesSchema() schema.Attribute {
 			Attributes: map[string]schema.Attribute{
 				"aggregate": aggregate.Schema(),
 				"filter": schema.StringAttribute{
-					Optional: true,
+					Optional:   true,
+					CustomType: customtypes.QueryType{},
 					Validators: []validator.String{
 						stringvalidator.LengthAtMost(10000),
+						stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("filter_expression")),
 					},
 				},
+				"filter_expression": filterexpression.Schema("Structured alternative to `filter`, compiled by the provider to an escaped query"),
 				"time_aggregate": schema.StringAttribute{
 					Optional:    true,
 					Description: "Per-series rollup applied within each time bucket before the cross-series aggregate. When omitted, a default is derived from the metric type.",
@@ -634,9 +655,7 @@ func visualizationListLogPatternsSchema() schema.Attribute {
 		Optional:    true,
 		Description: "Displays log patterns clustered from logs matching the query",
 		Attributes: map[string]schema.Attribute{
-			"type": schema.StringAttribute{
-				Computed: true,
-			},
+			"type": computedTypeSchema(),
 			"query": schema.StringAttribute{
 				Required:    true,
 				Description: "Tsuga query that selects logs to cluster into patterns",
@@ -696,7 +715,7 @@ func visualizationTopListConnectionSchema() schema.Attribute {
 		Optional:    true,
 		Description: "Displays the database rows-based aggregation as a ranked top list",
 		Attributes: map[string]schema.Attribute{
-			"type":          schema.StringAttribute{Computed: true},
+			"type":          computedTypeSchema(),
 			"connection_id": connectionIdSchema(),
 			"queries":       connectionSqlQueriesSchema(),
 		},
@@ -708,7 +727,7 @@ func visualizationPieConnectionSchema() schema.Attribute {
 		Optional:    true,
 		Description: "Displays the database rows-based aggregation as a pie chart",
 		Attributes: map[string]schema.Attribute{
-			"type":          schema.StringAttribute{Computed: true},
+			"type":          computedTypeSchema(),
 			"connection_id": connectionIdSchema(),
 			"queries":       connectionSqlQueriesSchema(),
 			"legend_mode":   legendModeSchema(),
@@ -721,7 +740,7 @@ func visualizationBarConnectionSchema() schema.Attribute {
 		Optional:    true,
 		Description: "Displays the database rows-based aggregation as a bar chart",
 		Attributes: map[string]schema.Attribute{
-			"type":            schema.StringAttribute{Computed: true},
+			"type":            computedTypeSchema(),
 			"connection_id":   connectionIdSchema(),
 			"queries":         connectionSqlQueriesSchema(),
 			"legend_mode":     legendModeSchema(),
@@ -736,7 +755,7 @@ func visualizationQueryValueConnectionSchema() schema.Attribute {
 		Optional:    true,
 		Description: "Displays a single value computed by a SQL query against a database connection",
 		Attributes: map[string]schema.Attribute{
-			"type":          schema.StringAttribute{Computed: true},
+			"type":          computedTypeSchema(),
 			"connection_id": connectionIdSchema(),
 			"queries":       connectionSqlQueriesSchema(),
 			"legend_mode":   legendModeSchema(),
@@ -760,9 +779,7 @@ func visualizationListSchema() schema.Attribute {
 	return schema.SingleNestedAttribute{
 		Optional: true,
 		Attributes: map[string]schema.Attribute{
-			"type": schema.StringAttribute{
-				Computed: true,
-			},
+			"type": computedTypeSchema(),
 			"query": schema.StringAttribute{
 				Required: true,
 				Validators: []validator.String{
@@ -789,9 +806,7 @@ func visualizationListSpansSchema() schema.Attribute {
 		Optional:    true,
 		Description: "Displays individual spans as a tabular list",
 		Attributes: map[string]schema.Attribute{
-			"type": schema.StringAttribute{
-				Computed: true,
-			},
+			"type": computedTypeSchema(),
 			"query": schema.StringAttribute{
 				Required: true,
 				Validators: []validator.String{
@@ -879,9 +894,7 @@ func visualizationTimeseriesConnectionSchema() schema.Attribute {
 		Optional:    true,
 		Description: "Displays database rows-based aggregation as a time series chart",
 		Attributes: map[string]schema.Attribute{
-			"type": schema.StringAttribute{
-				Computed: true,
-			},
+			"type": computedTypeSchema(),
 			"connection_id": schema.StringAttribute{
 				Required:    true,
 				Description: "The ID of the connection to use to query the datastore",
@@ -918,9 +931,7 @@ func visualizationListConnectionSchema() schema.Attribute {
 		Optional:    true,
 		Description: "Displays database rows as a tabular list",
 		Attributes: map[string]schema.Attribute{
-			"type": schema.StringAttribute{
-				Computed: true,
-			},
+			"type": computedTypeSchema(),
 			"connection_id": schema.StringAttribute{
 				Required:    true,
 				Description: "The ID of the connection to use to query the datastore",
@@ -954,9 +965,7 @@ func visualizationNoteSchema() schema.Attribute {
 	return schema.SingleNestedAttribute{
 		Optional: true,
 		Attributes: map[string]schema.Attribute{
-			"type": schema.StringAttribute{
-				Computed: true,
-			},
+			"type": computedTypeSchema(),
 			"note": schema.StringAttribute{
 				Required: true,
 				Validators: []validator.String{
@@ -998,14 +1007,16 @@ func visualizationNoteSchema() schema.Attribute {
 }
 
 type DashboardModel struct {
-	Id         types.String `tfsdk:"id"`
-	Name       types.String `tfsdk:"name"`
-	Owner      types.String `tfsdk:"owner"`
-	FolderId   types.String `tfsdk:"folder_id"`
-	Filters    types.List   `tfsdk:"filters"`
-	Tags       types.List   `tfsdk:"tags"`
-	TimePreset types.String `tfsdk:"time_preset"`
-	Graphs     types.List   `tfsdk:"graphs"`
+	Id                 types.String   `tfsdk:"id"`
+	Name               types.String   `tfsdk:"name"`
+	Owner              types.String   `tfsdk:"owner"`
+	FolderId           types.String   `tfsdk:"folder_id"`
+	Filters            types.List     `tfsdk:"filters"`
+	Tags               types.List     `tfsdk:"tags"`
+	TimePreset         types.String   `tfsdk:"time_preset"`
+	Graphs             types.List     `tfsdk:"graphs"`
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 type GraphModel struct {
@@ -1240,10 +1251,11 @@ type NoteVisualizationModel struct {
 }
 
 type QueryModel struct {
-	Aggregate     AggregateModel `tfsdk:"aggregate"`
-	Filter        types.String   `tfsdk:"filter"`
-	Functions     types.List     `tfsdk:"functions"`
-	TimeAggregate types.String   `tfsdk:"time_aggregate"`
+	Aggregate        AggregateModel         `tfsdk:"aggregate"`
+	Filter           customtypes.QueryValue `tfsdk:"filter"`
+	FilterExpression types.Object           `tfsdk:"filter_expression"`
+	Functions        types.List             `tfsdk:"functions"`
+	TimeAggregate    types.String           `tfsdk:"time_aggregate"`
 }
 
 type AggregateModel struct {
@@ -1577,10 +1589,11 @@ func NoteVisualizationAttrTypes() map[string]attr.Type {
 
 func QueryAttrTypes() map[string]attr.Type {
 	return map[string]attr.Type{
-		"aggregate":      types.ObjectType{AttrTypes: aggregate.AttrTypes()},
-		"filter":         types.StringType,
-		"functions":      types.ListType{ElemType: types.ObjectType{AttrTypes: FunctionAttrTypes()}},
-		"time_aggregate": types.StringType,
+		"aggregate":         types.ObjectType{AttrTypes: aggregate.AttrTypes()},
+		"filter":            customtypes.QueryType{},
+		"filter_expression": types.ObjectType{AttrTypes: filterexpression.AttrTypes()},
+		"functions":         types.ListType{ElemType: types.ObjectType{AttrTypes: FunctionAttrTypes()}},
+		"time_aggregate":    types.StringType,
 	}
 }
 
@@ -3,13 +3,19 @@ package resource_monitor
 import (
 	"context"
 	"terraform-provider-tsuga/internal/aggregate"
+	"terraform-provider-tsuga/internal/customtypes"
+	"terraform-provider-tsuga/internal/filterexpression"
 	"terraform-provider-tsuga/internal/resource_team"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -21,6 +27,9 @@ func MonitorResourceSchema(ctx context.Context) schema.Schema {
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "Identifier of the monitor",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
 				Required:    true,
@@ -125,40 +134,7 @@ func baseMonitorConfigurationAttributes() map[string]schema.Attribute {
 				int64validator.AtLeast(1),
 			},
 		},
-		"group_by_fields": schema.ListNestedAttribute{
-			Required: true,
-			Validators: []validator.List{
-				listvalidator.SizeAtMost(1),
-			},
-			NestedObject: schema.NestedAttributeObject{
-				Attributes: map[string]schema.Attribute{
-					"fields": schema.ListAttribute{
-						Required:    true,
-						ElementType: types.StringType,
-						Validators: []validator.List{
-							listvalidator.SizeAtLeast(1),
-						},
-					},
-					"limit": schema.Int64Attribute{
-						Required: true,
-					},
-					"sort_order": schema.StringAttribute{
-						Optional: true,
-						Validators: []validator.String{
-							stringvalidator.OneOf("asc", "desc"),
-						},
-						Description: "Sort direction applied to groups: 'asc' or 'desc'.",
-					},
-					"replace_null_with": schema.StringAttribute{
-						Optional: true,
-						Validators: []validator.String{
-							stringvalidator.LengthAtLeast(1),
-						},
-						Description: "Value used to group documents that have no value for a grouped field.",
-					},
-				},
-			},
-		},
+		"group_by_fields": GroupByFieldsSchema(),
 		"aggregation_alert_logic": schema.StringAttribute{
 			Required: true,
 			Validators: []validator.String{
@@ -174,6 +150,45 @@ func baseMonitorConfigurationAttributes() map[string]schema.Attribute {
 	}
 }
 
+// GroupByFieldsSchema returns the schema of the `group_by_fields` of monitor
+// configurations. It is exported for the data sources evaluating monitor queries.
+func GroupByFieldsSchema() schema.Attribute {
+	return schema.ListNestedAttribute{
+		Required: true,
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"fields": schema.ListAttribute{
+					Required:    true,
+					ElementType: types.StringType,
+					Validators: []validator.List{
+						listvalidator.SizeAtLeast(1),
+					},
+				},
+				"limit": schema.Int64Attribute{
+					Required: true,
+				},
+				"sort_order": schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("asc", "desc"),
+					},
+					Description: "Sort direction applied to groups: 'asc' or 'desc'.",
+				},
+				"replace_null_with": schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+					Description: "Value used to group documents that have no value for a grouped field.",
+				},
+			},
+		},
+	}
+}
+
 func monitorConditionSchema() schema.Attribute {
 	return schema.ListNestedAttribute{
 		Required: true,
@@ -192,7 +207,8 @@ func monitorConditionSchema() schema.Attribute {
 					},
 				},
 				"threshold": schema.Float64Attribute{
-					Required: true,
+					Required:   true,
+					CustomType: customtypes.Float64Type{},
 				},
 			},
 		},
@@ -240,14 +256,18 @@ func QueriesSchema() schema.Attribute {
 		NestedObject: schema.NestedAttributeObject{
 			Attributes: map[string]schema.Attribute{
 				"filter": schema.StringAttribute{
-					Required: true,
+					Optional:    true,
+					CustomType:  customtypes.QueryType{},
+					Description: "Query selecting the data to aggregate. Exactly one of `filter` and `filter_expression` must be set.",
 					Validators: []validator.String{
 						stringvalidator.LengthAtMost(10000),
+						stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("filter_expression")),
 					},
 				},
-				"aggregate": aggregate.Schema(),
-				"functions": aggregationFunctionsSchema(),
-				"fill":      aggregationFillSchema(),
+				"filter_expression": filterexpression.Schema("Structured alternative to `filter`, compiled by the provider to an escaped query"),
+				"aggregate":         aggregate.Schema(),
+				"functions":         aggregationFunctionsSchema(),
+				"fill":              aggregationFillSchema(),
 				"time_aggregate": schema.StringAttribute{
 					Optional:    true,
 					Description: "Per-series rollup applied within each time bucket before the cross-series aggregate. When omitted, a default is derived from the metric type.",
@@ -355,7 +375,8 @@ func thresholdMonitorConfigurationSchema() schema.Attribute {
 				Optional: true,
 			},
 			"threshold": schema.Float64Attribute{
-				Optional: true,
+				Optional:   true,
+				CustomType: customtypes.Float64Type{},
 			},
 		},
 	}
@@ -462,16 +483,18 @@ func logErrorPatternMonitorConfigurationSchema() schema.Attribute {
 
 // Model types
 type MonitorModel struct {
-	Id            types.String              `tfsdk:"id"`
-	Name          types.String              `tfsdk:"name"`
-	Message       types.String              `tfsdk:"message"`
-	Tags          types.List                `tfsdk:"tags"`
-	Configuration MonitorConfigurationModel `tfsdk:"configuration"`
-	Priority      types.Int64               `tfsdk:"priority"`
-	Owner         types.String              `tfsdk:"owner"`
-	DashboardId   types.String              `tfsdk:"dashboard_id"`
-	Permissions   types.String              `tfsdk:"permissions"`
-	ClusterIds    types.List                `tfsdk:"cluster_ids"`
+	Id                 types.String              `tfsdk:"id"`
+	Name               types.String              `tfsdk:"name"`
+	Message            types.String              `tfsdk:"message"`
+	Tags               types.List                `tfsdk:"tags"`
+	Configuration      MonitorConfigurationModel `tfsdk:"configuration"`
+	Priority           types.Int64               `tfsdk:"priority"`
+	Owner              types.String              `tfsdk:"owner"`
+	DashboardId        types.String              `tfsdk:"dashboard_id"`
+	Permissions        types.String              `tfsdk:"permissions"`
+	ClusterIds         types.List                `tfsdk:"cluster_ids"`
+	DeletionProtection types.Bool                `tfsdk:"deletion_protection"`
+	Timeouts           timeouts.Value            `tfsdk:"timeouts"`
 }
 
 type MonitorConfigurationModel struct {
@@ -509,9 +532,9 @@ type MonitorConfigurationDetailsModel struct {
 }
 
 type MonitorConditionModel struct {
-	Formula   types.String  `tfsdk:"formula"`
-	Operator  types.String  `tfsdk:"operator"`
-	Threshold types.Float64 `tfsdk:"threshold"`
+	Formula   types.String             `tfsdk:"formula"`
+	Operator  types.String             `tfsdk:"operator"`
+	Threshold customtypes.Float64Value `tfsdk:"threshold"`
 }
 
 type AnomalyMonitorConfigurationDetailsModel struct {
@@ -536,11 +559,12 @@ type CertificateExpiryMonitorConfigurationModel struct {
 }
 
 type MonitorQueryModel struct {
-	Filter        types.String          `tfsdk:"filter"`
-	Aggregate     MonitorAggregateModel `tfsdk:"aggregate"`
-	Functions     types.List            `tfsdk:"functions"`
-	Fill          *AggregationFillModel `tfsdk:"fill"`
-	TimeAggregate types.String          `tfsdk:"time_aggregate"`
+	Filter           customtypes.QueryValue `tfsdk:"filter"`
+	FilterExpression types.Object           `tfsdk:"filter_expression"`
+	Aggregate        MonitorAggregateModel  `tfsdk:"aggregate"`
+	Functions        types.List             `tfsdk:"functions"`
+	Fill             *AggregationFillModel  `tfsdk:"fill"`
+	TimeAggregate    types.String           `tfsdk:"time_aggregate"`
 }
 
 type MonitorAggregateModel struct {
@@ -586,17 +610,18 @@ func MonitorConditionAttrTypes() map[string]attr.Type {
 	return map[string]attr.Type{
 		"formula":   types.StringType,
 		"operator":  types.StringType,
-		"threshold": types.Float64Type,
+		"threshold": customtypes.Float64Type{},
 	}
 }
 
 func QueryAttrTypes() map[string]attr.Type {
 	return map[string]attr.Type{
-		"filter":         types.StringType,
-		"aggregate":      types.ObjectType{AttrTypes: aggregate.AttrTypes()},
-		"functions":      types.ListType{ElemType: types.ObjectType{AttrTypes: AggregationFunctionAttrTypes()}},
-		"fill":           types.ObjectType{AttrTypes: AggregationFillAttrTypes()},
-		"time_aggregate": types.StringType,
+		"filter":            customtypes.QueryType{},
+		"filter_expression": types.ObjectType{AttrTypes: filterexpression.AttrTypes()},
+		"aggregate":         types.ObjectType{AttrTypes: aggregate.AttrTypes()},
+		"functions":         types.ListType{ElemType: types.ObjectType{AttrTypes: AggregationFunctionAttrTypes()}},
+		"fill":              types.ObjectType{AttrTypes: AggregationFillAttrTypes()},
+		"time_aggregate":    types.StringType,
 	}
 }
 
@@ -3,17 +3,24 @@ package resource_notification_rule
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
+	"terraform-provider-tsuga/internal/customtypes"
+	"terraform-provider-tsuga/internal/filterexpression"
+	"terraform-provider-tsuga/internal/planmodifiers"
 	"terraform-provider-tsuga/internal/resource_team"
 	"terraform-provider-tsuga/internal/teamsfilter"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 )
 
 func NotificationRuleResourceSchema(ctx context.Context) schema.Schema {
@@ -23,6 +30,9 @@ func NotificationRuleResourceSchema(ctx context.Context) schema.Schema {
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "Identifier of the notification rule",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
 				Required:    true,
@@ -32,13 +42,16 @@ func NotificationRuleResourceSchema(ctx context.Context) schema.Schema {
 				},
 			},
 			"query_string": schema.StringAttribute{
+				CustomType:  customtypes.QueryType{},
 				Optional:    true,
 				Description: "Optional query that narrows which alert transitions trigger the rule. Matches on the monitor transition group key and the monitor tags, e.g. `env:prod service:api`. Omit or leave empty to match regardless of tags.",
 				Validators: []validator.String{
 					stringvalidator.LengthAtMost(10000),
+					stringvalidator.ConflictsWith(path.MatchRoot("filter_expression")),
 				},
 			},
-			"teams_filter": teamsfilter.Schema("Team filter that narrows down the teams that can receive notifications from this rule"),
+			"filter_expression": filterexpression.Schema("Structured alternative to `query_string`, compiled by the provider to an escaped query"),
+			"teams_filter":      teamsfilter.Schema("Team filter that narrows down the teams that can receive notifications from this rule"),
 			"priorities_filter": schema.ListAttribute{
 				Required:    true,
 				Description: "Priorities that narrow down the alerts that can trigger a notification",
@@ -116,9 +129,7 @@ func NotificationRuleResourceSchema(ctx context.Context) schema.Schema {
 								"slack": schema.SingleNestedAttribute{
 									Optional: true,
 									Attributes: map[string]schema.Attribute{
-										"type": schema.StringAttribute{
-											Computed: true,
-										},
+										"type": computedTypeSchema(),
 										"channel": schema.StringAttribute{
 											Required: true,
 											Validators: []validator.String{
@@ -147,9 +158,7 @@ func NotificationRuleResourceSchema(ctx context.Context) schema.Schema {
 								"incident_io": schema.SingleNestedAttribute{
 									Optional: true,
 									Attributes: map[string]schema.Attribute{
-										"type": schema.StringAttribute{
-											Computed: true,
-										},
+										"type": computedTypeSchema(),
 										"integration_id": schema.StringAttribute{
 											Required: true,
 											Validators: []validator.String{
@@ -164,9 +173,7 @@ func NotificationRuleResourceSchema(ctx context.Context) schema.Schema {
 								"pagerduty": schema.SingleNestedAttribute{
 									Optional: true,
 									Attributes: map[string]schema.Attribute{
-										"type": schema.StringAttribute{
-											Computed: true,
-										},
+										"type": computedTypeSchema(),
 										"integration_id": schema.StringAttribute{
 											Required: true,
 											Validators: []validator.String{
@@ -181,9 +188,7 @@ func NotificationRuleResourceSchema(ctx context.Context) schema.Schema {
 								"email": schema.SingleNestedAttribute{
 									Optional: true,
 									Attributes: map[string]schema.Attribute{
-										"type": schema.StringAttribute{
-											Computed: true,
-										},
+										"type": computedTypeSchema(),
 										"addresses": schema.ListAttribute{
 											Required:    true,
 											ElementType: types.StringType,
@@ -193,9 +198,7 @@ func NotificationRuleResourceSchema(ctx context.Context) schema.Schema {
 								"grafana_irm": schema.SingleNestedAttribute{
 									Optional: true,
 									Attributes: map[string]schema.Attribute{
-										"type": schema.StringAttribute{
-											Computed: true,
-										},
+										"type": computedTypeSchema(),
 										"integration_id": schema.StringAttribute{
 											Required: true,
 											Validators: []validator.String{
@@ -210,9 +213,7 @@ func NotificationRuleResourceSchema(ctx context.Context) schema.Schema {
 								"microsoft_teams": schema.SingleNestedAttribute{
 									Optional: true,
 									Attributes: map[string]schema.Attribute{
-										"type": schema.StringAttribute{
-											Computed: true,
-										},
+										"type": computedTypeSchema(),
 										"integration_id": schema.StringAttribute{
 											Required: true,
 											Validators: []validator.String{
@@ -227,9 +228,7 @@ func NotificationRuleResourceSchema(ctx context.Context) schema.Schema {
 								"webhook": schema.SingleNestedAttribute{
 									Optional: true,
 									Attributes: map[string]schema.Attribute{
-										"type": schema.StringAttribute{
-											Computed: true,
-										},
+										"type": computedTypeSchema(),
 										"integration_id": schema.StringAttribute{
 											Required: true,
 											Validators: []validator.String{
@@ -244,9 +243,7 @@ func NotificationRuleResourceSchema(ctx context.Context) schema.Schema {
 								"squadcast": schema.SingleNestedAttribute{
 									Optional: true,
 									Attributes: map[string]schema.Attribute{
-										"type": schema.StringAttribute{
-											Computed: true,
-										},
+										"type": computedTypeSchema(),
 										"integration_id": schema.StringAttribute{
 											Required: true,
 											Validators: []validator.String{
@@ -301,17 +298,33 @@ func NotificationRuleResourceSchema(ctx context.Context) schema.Schema {
 	}
 }
 
+// computedTypeSchema returns the schema for a target's `type`. The provider sets
+// it from the target block it sits in, so it never changes once known and plans
+// keep it from state.
+func computedTypeSchema() schema.Attribute {
+	return schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			planmodifiers.UseNonNullStateForUnknown(),
+		},
+	}
+}
+
 type NotificationRuleModel struct {
-	Id                    types.String       `tfsdk:"id"`
-	Name                  types.String       `tfsdk:"name"`
-	QueryString           types.String       `tfsdk:"query_string"`
-	TeamsFilter           *teamsfilter.Model `tfsdk:"teams_filter"`
-	PrioritiesFilter      types.List         `tfsdk:"priorities_filter"`
-	TransitionTypesFilter types.List         `tfsdk:"transition_types_filter"`
-	Owner                 types.String       `tfsdk:"owner"`
-	Tags                  types.List         `tfsdk:"tags"`
-	IsActive              types.Bool         `tfsdk:"is_active"`
-	Targets               types.List         `tfsdk:"targets"`
+	Id                    types.String           `tfsdk:"id"`
+	Name                  types.String           `tfsdk:"name"`
+	QueryString           customtypes.QueryValue `tfsdk:"query_string"`
+	FilterExpression      types.Object           `tfsdk:"filter_expression"`
+	TeamsFilter           *teamsfilter.Model     `tfsdk:"teams_filter"`
+	PrioritiesFilter      types.List             `tfsdk:"priorities_filter"`
+	TransitionTypesFilter types.List             `tfsdk:"transition_types_filter"`
+	Owner                 types.String           `tfsdk:"owner"`
+	Tags                  types.List             `tfsdk:"tags"`
+	IsActive              types.Bool             `tfsdk:"is_active"`
+	Targets               types.List             `tfsdk:"targets"`
+	OnDestroy             types.String           `tfsdk:"on_destroy"`
+	DeletionProtection    types.Bool             `tfsdk:"deletion_protection"`
+	Timeouts              timeouts.Value         `tfsdk:"timeouts"`
 }
 
 type TargetModel struct {
@@ -3,17 +3,22 @@ package resource_notification_silence
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
+	"terraform-provider-tsuga/internal/filterexpression"
 	"terraform-provider-tsuga/internal/resource_team"
 	"terraform-provider-tsuga/internal/teamsfilter"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 )
 
 func NotificationSilenceResourceSchema(ctx context.Context) schema.Schema {
@@ -23,6 +28,9 @@ func NotificationSilenceResourceSchema(ctx context.Context) schema.Schema {
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "Unique identifier of the silence",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
 				Required:    true,
@@ -176,9 +184,11 @@ func NotificationSilenceResourceSchema(ctx context.Context) schema.Schema {
 				Description: "Query string filtering which alerts this silence applies to",
 				Validators: []validator.String{
 					stringvalidator.LengthAtMost(10000),
+					stringvalidator.ConflictsWith(path.MatchRoot("filter_expression")),
 				},
 			},
-			"teams_filter": teamsfilter.Schema("Team filter that narrows down which teams' alerts this silence applies to"),
+			"filter_expression": filterexpression.Schema("Structured alternative to `query_string`, compiled by the provider to an escaped query"),
+			"teams_filter":      teamsfilter.Schema("Team filter that narrows down which teams' alerts this silence applies to"),
 			"priorities_filter": schema.ListAttribute{
 				Required:    true,
 				Description: "Monitor priorities filtering which alerts this silence applies to",
@@ -226,9 +236,12 @@ type NotificationSilenceModel struct {
 	Schedule              *ScheduleModel     `tfsdk:"schedule"`
 	NotificationRuleIds   types.List         `tfsdk:"notification_rule_ids"`
 	QueryString           types.String       `tfsdk:"query_string"`
+	FilterExpression      types.Object       `tfsdk:"filter_expression"`
 	TeamsFilter           *teamsfilter.Model `tfsdk:"teams_filter"`
 	PrioritiesFilter      types.List         `tfsdk:"priorities_filter"`
 	TransitionTypesFilter types.List         `tfsdk:"transition_types_filter"`
+	OnDestroy             types.String       `tfsdk:"on_destroy"`
+	Timeouts              timeouts.Value     `tfsdk:"timeouts"`
 }
 
 type ScheduleModel struct {
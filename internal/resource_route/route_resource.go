@@ -3,15 +3,21 @@ package resource_route
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
+	"terraform-provider-tsuga/internal/customtypes"
+	"terraform-provider-tsuga/internal/filterexpression"
 	"terraform-provider-tsuga/internal/resource_team"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 )
 
 // MaxSplitDepth caps how many nested split processors we model without causing
@@ -25,6 +31,9 @@ func RouteResourceSchema(ctx context.Context) schema.Schema {
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "Identifier of the log route",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
 				Required:    true,
@@ -43,12 +52,15 @@ func RouteResourceSchema(ctx context.Context) schema.Schema {
 				Required: true,
 			},
 			"query": schema.StringAttribute{
-				Required:    true,
-				Description: "Query that selects which logs should enter the route",
+				Optional:    true,
+				CustomType:  customtypes.QueryType{},
+				Description: "Query that selects which logs should enter the route. Exactly one of `query` and `filter_expression` must be set.",
 				Validators: []validator.String{
 					stringvalidator.LengthAtMost(50000),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("filter_expression")),
 				},
 			},
+			"filter_expression": filterexpression.Schema("Structured alternative to `query`, compiled by the provider to an escaped query"),
 			"owner": schema.StringAttribute{
 				Required:    true,
 				Description: "Team ID owning and managing the route",
@@ -329,12 +341,15 @@ func processorsListAttribute(ctx context.Context, depth int) schema.ListNestedAt
 									NestedObject: schema.NestedAttributeObject{
 										Attributes: map[string]schema.Attribute{
 											"query": schema.StringAttribute{
-												Required:    true,
-												Description: "Query that selects the logs assigned to this category",
+												Optional:    true,
+												CustomType:  customtypes.QueryType{},
+												Description: "Query that selects the logs assigned to this category. Exactly one of `query` and `filter_expression` must be set.",
 												Validators: []validator.String{
 													stringvalidator.LengthBetween(1, 10000),
+													stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("filter_expression")),
 												},
 											},
+											"filter_expression": filterexpression.Schema("Structured alternative to `query`, compiled by the provider to an escaped query"),
 											"value": schema.StringAttribute{
 												Required:    true,
 												Description: "Category value assigned when the query matches",
@@ -375,12 +390,15 @@ func splitSchema(ctx context.Context, depth int) schema.Attribute {
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"query": schema.StringAttribute{
-							Required:    true,
-							Description: "Query that determines whether logs enter this branch",
+							Optional:    true,
+							CustomType:  customtypes.QueryType{},
+							Description: "Query that determines whether logs enter this branch. Exactly one of `query` and `filter_expression` must be set.",
 							Validators: []validator.String{
 								stringvalidator.LengthAtMost(50000),
+								stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("filter_expression")),
 							},
 						},
+						"filter_expression": filterexpression.Schema("Structured alternative to `query`, compiled by the provider to an escaped query"),
 						"processors": func() schema.Attribute {
 							attr := processorsListAttribute(ctx, depth)
 							attr.Description = "Processors executed when the branch query matches"
@@ -394,14 +412,18 @@ func splitSchema(ctx context.Context, depth int) schema.Attribute {
 }
 
 type RouteModel struct {
-	Id          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	IsEnabled   types.Bool   `tfsdk:"is_enabled"`
-	Query       types.String `tfsdk:"query"`
-	Owner       types.String `tfsdk:"owner"`
-	Tags        types.List   `tfsdk:"tags"`
-	Processors  types.List   `tfsdk:"processors"`
+	Id                 types.String           `tfsdk:"id"`
+	Name               types.String           `tfsdk:"name"`
+	Description        types.String           `tfsdk:"description"`
+	IsEnabled          types.Bool             `tfsdk:"is_enabled"`
+	Query              customtypes.QueryValue `tfsdk:"query"`
+	FilterExpression   types.Object           `tfsdk:"filter_expression"`
+	Owner              types.String           `tfsdk:"owner"`
+	Tags               types.List             `tfsdk:"tags"`
+	Processors         types.List             `tfsdk:"processors"`
+	OnDestroy          types.String           `tfsdk:"on_destroy"`
+	DeletionProtection types.Bool             `tfsdk:"deletion_protection"`
+	Timeouts           timeouts.Value         `tfsdk:"timeouts"`
 }
 
 type ProcessorModel struct {
@@ -491,8 +513,9 @@ type CreatorCategoryModel struct {
 }
 
 type CreatorCategoryClauseModel struct {
-	Query types.String `tfsdk:"query"`
-	Value types.String `tfsdk:"value"`
+	Query            customtypes.QueryValue `tfsdk:"query"`
+	FilterExpression types.Object           `tfsdk:"filter_expression"`
+	Value            types.String           `tfsdk:"value"`
 }
 
 type SplitModel struct {
@@ -500,8 +523,9 @@ type SplitModel struct {
 }
 
 type SplitItemModel struct {
-	Query      types.String `tfsdk:"query"`
-	Processors types.List   `tfsdk:"processors"`
+	Query            customtypes.QueryValue `tfsdk:"query"`
+	FilterExpression types.Object           `tfsdk:"filter_expression"`
+	Processors       types.List             `tfsdk:"processors"`
 }
 
 // ProcessorAttrTypesAtDepth returns attribute types for processors while
@@ -640,8 +664,9 @@ func CreatorCategoryAttrTypes() map[string]attr.Type {
 
 func CreatorCategoryClauseAttrTypes() map[string]attr.Type {
 	return map[string]attr.Type{
-		"query": types.StringType,
-		"value": types.StringType,
+		"query":             customtypes.QueryType{},
+		"filter_expression": types.ObjectType{AttrTypes: filterexpression.AttrTypes()},
+		"value":             types.StringType,
 	}
 }
 
@@ -684,7 +709,8 @@ func splitItemAttrTypesWithDepth(ctx context.Context, depth int) map[string]attr
 		return map[string]attr.Type{}
 	}
 	return map[string]attr.Type{
-		"query":      types.StringType,
-		"processors": types.ListType{ElemType: types.ObjectType{AttrTypes: processorAttrTypesWithDepth(ctx, depth)}},
+		"query":             customtypes.QueryType{},
+		"filter_expression": types.ObjectType{AttrTypes: filterexpression.AttrTypes()},
+		"processors":        types.ListType{ElemType: types.ObjectType{AttrTypes: processorAttrTypesWithDepth(ctx, depth)}},
 	}
 }
@@ -2,9 +2,11 @@ package resource_slo
 
 import (
 	"context"
+	"terraform-provider-tsuga/internal/customtypes"
 	"terraform-provider-tsuga/internal/resource_monitor"
 	"terraform-provider-tsuga/internal/resource_team"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
@@ -27,6 +29,9 @@ func SloResourceSchema(ctx context.Context) schema.Schema {
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "Identifier of the SLO",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
 				Required:    true,
@@ -85,6 +90,7 @@ func SloResourceSchema(ctx context.Context) schema.Schema {
 			},
 			"target": schema.Float64Attribute{
 				Required:    true,
+				CustomType:  customtypes.Float64Type{},
 				Description: "Target percentage (0 < target < 100, e.g. 99.9)",
 				Validators: []validator.Float64{
 					// The API requires an exclusive 0 < target < 100 bound.
@@ -140,6 +146,7 @@ func SloResourceSchema(ctx context.Context) schema.Schema {
 							Attributes: map[string]schema.Attribute{
 								"burn_rate": schema.Float64Attribute{
 									Optional:    true,
+									CustomType:  customtypes.Float64Type{},
 									Description: "Burn rate multiplier that triggers the alert (1-100). The short/long evaluation windows are derived from the predefined template with the closest burn rate.",
 									Validators: []validator.Float64{
 										float64validator.Between(1, 100),
@@ -147,6 +154,7 @@ func SloResourceSchema(ctx context.Context) schema.Schema {
 								},
 								"threshold": schema.Float64Attribute{
 									Optional:    true,
+									CustomType:  customtypes.Float64Type{},
 									Description: "SLO target percentage below which the alert triggers (0 < threshold < 100)",
 									Validators: []validator.Float64{
 										// The API requires an exclusive 0 < threshold < 100 bound.
@@ -280,6 +288,7 @@ func sloTimeConfigurationSchema() schema.Attribute {
 					},
 					"value": schema.Float64Attribute{
 						Required:    true,
+						CustomType:  customtypes.Float64Type{},
 						Description: "Threshold value compared against the query signal",
 					},
 				},
@@ -299,17 +308,19 @@ func sloTimeConfigurationSchema() schema.Attribute {
 // Model types
 
 type SloModel struct {
-	Id            types.String          `tfsdk:"id"`
-	Name          types.String          `tfsdk:"name"`
-	Description   types.String          `tfsdk:"description"`
-	Tags          types.List            `tfsdk:"tags"`
-	Configuration SloConfigurationModel `tfsdk:"configuration"`
-	Target        types.Float64         `tfsdk:"target"`
-	TimeframeDays types.Int64           `tfsdk:"timeframe_days"`
-	Owner         types.String          `tfsdk:"owner"`
-	Permissions   types.String          `tfsdk:"permissions"`
-	ClusterIds    types.List            `tfsdk:"cluster_ids"`
-	Alerts        types.List            `tfsdk:"alerts"`
+	Id                 types.String             `tfsdk:"id"`
+	Name               types.String             `tfsdk:"name"`
+	Description        types.String             `tfsdk:"description"`
+	Tags               types.List               `tfsdk:"tags"`
+	Configuration      SloConfigurationModel    `tfsdk:"configuration"`
+	Target             customtypes.Float64Value `tfsdk:"target"`
+	TimeframeDays      types.Int64              `tfsdk:"timeframe_days"`
+	Owner              types.String             `tfsdk:"owner"`
+	Permissions        types.String             `tfsdk:"permissions"`
+	ClusterIds         types.List               `tfsdk:"cluster_ids"`
+	Alerts             types.List               `tfsdk:"alerts"`
+	DeletionProtection types.Bool               `tfsdk:"deletion_protection"`
+	Timeouts           timeouts.Value           `tfsdk:"timeouts"`
 }
 
 type SloConfigurationModel struct {
@@ -340,8 +351,8 @@ type SloQueryFormulaModel struct {
 }
 
 type SloTimeThresholdModel struct {
-	Operator types.String  `tfsdk:"operator"`
-	Value    types.Float64 `tfsdk:"value"`
+	Operator types.String             `tfsdk:"operator"`
+	Value    customtypes.Float64Value `tfsdk:"value"`
 }
 
 type SloAlertModel struct {
@@ -351,8 +362,8 @@ type SloAlertModel struct {
 }
 
 type SloAlertConfigurationModel struct {
-	BurnRate  types.Float64 `tfsdk:"burn_rate"`
-	Threshold types.Float64 `tfsdk:"threshold"`
+	BurnRate  customtypes.Float64Value `tfsdk:"burn_rate"`
+	Threshold customtypes.Float64Value `tfsdk:"threshold"`
 }
 
 // BurnRateSet reports whether burn_rate holds a concrete (non-null, known) value.
@@ -377,7 +388,7 @@ func SloAlertAttrTypes() map[string]attr.Type {
 // SloAlertConfigurationAttrTypes returns the attr types for an SLO alert configuration object.
 func SloAlertConfigurationAttrTypes() map[string]attr.Type {
 	return map[string]attr.Type{
-		"burn_rate": types.Float64Type,
-		"threshold": types.Float64Type,
+		"burn_rate": customtypes.Float64Type{},
+		"threshold": customtypes.Float64Type{},
 	}
 }
@@ -1,12 +1,17 @@
 package resource_tag_policy
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-tsuga/internal/planmodifiers"
 )
 
 func TagPolicyResourceSchema() schema.Schema {
@@ -16,6 +21,9 @@ func TagPolicyResourceSchema() schema.Schema {
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "Identifier of the tag policy",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
 				Required:    true,
@@ -86,6 +94,9 @@ func TagPolicyResourceSchema() schema.Schema {
 						Attributes: map[string]schema.Attribute{
 							"type": schema.StringAttribute{
 								Computed: true,
+								PlanModifiers: []planmodifier.String{
+									planmodifiers.UseNonNullStateForUnknown(),
+								},
 							},
 							"asset_types": schema.ListAttribute{
 								Required:    true,
@@ -117,6 +128,9 @@ func TagPolicyResourceSchema() schema.Schema {
 						Attributes: map[string]schema.Attribute{
 							"type": schema.StringAttribute{
 								Computed: true,
+								PlanModifiers: []planmodifier.String{
+									planmodifiers.UseNonNullStateForUnknown(),
+								},
 							},
 							"asset_types": schema.ListAttribute{
 								Required:    true,
@@ -166,6 +180,8 @@ type TagPolicyModel struct {
 	TeamScope        *TeamScopeModel     `tfsdk:"team_scope"`
 	Configuration    *ConfigurationModel `tfsdk:"configuration"`
 	Owner            types.String        `tfsdk:"owner"`
+	OnDestroy        types.String        `tfsdk:"on_destroy"`
+	Timeouts         timeouts.Value      `tfsdk:"timeouts"`
 }
 
 type TeamScopeModel struct {
@@ -3,6 +3,7 @@ package resource_team_membership
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -18,6 +19,9 @@ func TeamMembershipResourceSchema(_ context.Context) schema.Schema {
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "Identifier of the team membership",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"user_id": schema.StringAttribute{
 				Required:    true,
@@ -51,8 +55,9 @@ func TeamMembershipResourceSchema(_ context.Context) schema.Schema {
 }
 
 type TeamMembershipModel struct {
-	Id      types.String `tfsdk:"id"`
-	UserId  types.String `tfsdk:"user_id"`
-	TeamId  types.String `tfsdk:"team_id"`
-	RoleKey types.String `tfsdk:"role_key"`
+	Id       types.String   `tfsdk:"id"`
+	UserId   types.String   `tfsdk:"user_id"`
+	TeamId   types.String   `tfsdk:"team_id"`
+	RoleKey  types.String   `tfsdk:"role_key"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
@@ -0,0 +1,134 @@
+package routesim
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Event is a log event being transformed by the pipeline. Nested attributes are
+// addressed with dotted paths such as `http.status_code`.
+type Event map[string]any
+
+// Get returns the attribute at path. A top-level key containing dots takes precedence
+// over the nested lookup, so both `{"a.b": 1}` and `{"a": {"b": 1}}` resolve `a.b`.
+func (e Event) Get(path string) (any, bool) {
+	if v, ok := e[path]; ok {
+		return v, true
+	}
+	segments := strings.Split(path, ".")
+	var current any = map[string]any(e)
+	for _, segment := range segments {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		current, ok = m[segment]
+		if !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// Has reports whether the attribute at path is set.
+func (e Event) Has(path string) bool {
+	_, ok := e.Get(path)
+	return ok
+}
+
+// Set writes value at path, creating intermediate objects as needed.
+func (e Event) Set(path string, value any) {
+	if _, ok := e[path]; ok {
+		e[path] = value
+		return
+	}
+	segments := strings.Split(path, ".")
+	target := map[string]any(e)
+	for _, segment := range segments[:len(segments)-1] {
+		next, ok := target[segment].(map[string]any)
+		if !ok {
+			next = map[string]any{}
+			target[segment] = next
+		}
+		target = next
+	}
+	target[segments[len(segments)-1]] = value
+}
+
+// Delete removes the attribute at path.
+func (e Event) Delete(path string) {
+	if _, ok := e[path]; ok {
+		delete(e, path)
+		return
+	}
+	segments := strings.Split(path, ".")
+	target := map[string]any(e)
+	for _, segment := range segments[:len(segments)-1] {
+		next, ok := target[segment].(map[string]any)
+		if !ok {
+			return
+		}
+		target = next
+	}
+	delete(target, segments[len(segments)-1])
+}
+
+// merge deep-merges fields into the event, so that extracted nested objects extend
+// existing ones instead of replacing them.
+func (e Event) merge(fields map[string]any) {
+	mergeInto(e, fields)
+}
+
+func mergeInto(dst, src map[string]any) {
+	for k, v := range src {
+		if srcMap, ok := v.(map[string]any); ok {
+			if dstMap, ok := dst[k].(map[string]any); ok {
+				mergeInto(dstMap, srcMap)
+				continue
+			}
+		}
+		dst[k] = v
+	}
+}
+
+// clone returns a deep copy of the event.
+func (e Event) clone() Event {
+	return Event(cloneValue(map[string]any(e)).(map[string]any))
+}
+
+func cloneValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for k, el := range v {
+			out[k] = cloneValue(el)
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, el := range v {
+			out[i] = cloneValue(el)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// stringValue renders an attribute the way it is substituted into format strings.
+func stringValue(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return formatNumber(v)
+	case map[string]any, []any:
+		encoded, _ := json.Marshal(v)
+		return string(encoded)
+	default:
+		return fmt.Sprint(v)
+	}
+}
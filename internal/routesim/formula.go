@@ -0,0 +1,212 @@
+package routesim
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// errMissingAttribute is returned when a formula or format string references an
+// attribute that is not set and the processor is not configured to replace it.
+type errMissingAttribute struct {
+	name string
+}
+
+func (e *errMissingAttribute) Error() string {
+	return fmt.Sprintf("attribute %q is not set", e.name)
+}
+
+// renderTemplate replaces every {{attribute}} reference in template with the
+// attribute value. Missing attributes render as empty strings when replaceMissing is
+// set and abort the rendering otherwise.
+func renderTemplate(template string, event Event, replaceMissing bool) (string, error) {
+	var b strings.Builder
+	rest := template
+	for {
+		start := strings.Index(rest, "{{")
+		if start < 0 {
+			b.WriteString(rest)
+			return b.String(), nil
+		}
+		end := strings.Index(rest[start:], "}}")
+		if end < 0 {
+			return "", fmt.Errorf("unterminated {{ in format string")
+		}
+		end += start
+
+		b.WriteString(rest[:start])
+		name := strings.TrimSpace(rest[start+2 : end])
+		v, ok := event.Get(name)
+		switch {
+		case ok:
+			b.WriteString(stringValue(v))
+		case !replaceMissing:
+			return "", &errMissingAttribute{name: name}
+		}
+		rest = rest[end+2:]
+	}
+}
+
+// evalFormula evaluates an arithmetic formula over attribute references, for example
+// `{{intake.latency_s}} * 1000 + {{store.latency_ms}}`. It supports + - * / %,
+// parentheses and unary minus.
+func evalFormula(formula string, event Event, replaceMissing bool) (float64, error) {
+	p := &formulaParser{src: formula, event: event, replaceMissing: replaceMissing}
+	v, err := p.parseSum()
+	if err != nil {
+		return 0, err
+	}
+	p.skipSpaces()
+	if p.pos < len(p.src) {
+		return 0, fmt.Errorf("unexpected %q at offset %d in formula", p.src[p.pos:p.pos+1], p.pos)
+	}
+	if math.IsInf(v, 0) || math.IsNaN(v) {
+		return 0, fmt.Errorf("formula does not evaluate to a finite number")
+	}
+	return v, nil
+}
+
+type formulaParser struct {
+	src            string
+	pos            int
+	event          Event
+	replaceMissing bool
+}
+
+func (p *formulaParser) skipSpaces() {
+	for p.pos < len(p.src) && (p.src[p.pos] == ' ' || p.src[p.pos] == '\t') {
+		p.pos++
+	}
+}
+
+func (p *formulaParser) parseSum() (float64, error) {
+	left, err := p.parseProduct()
+	if err != nil {
+		return 0, err
+	}
+	for {
+		p.skipSpaces()
+		if p.pos >= len(p.src) || (p.src[p.pos] != '+' && p.src[p.pos] != '-') {
+			return left, nil
+		}
+		op := p.src[p.pos]
+		p.pos++
+		right, err := p.parseProduct()
+		if err != nil {
+			return 0, err
+		}
+		if op == '+' {
+			left += right
+		} else {
+			left -= right
+		}
+	}
+}
+
+func (p *formulaParser) parseProduct() (float64, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return 0, err
+	}
+	for {
+		p.skipSpaces()
+		if p.pos >= len(p.src) || !strings.ContainsRune("*/%", rune(p.src[p.pos])) {
+			return left, nil
+		}
+		op := p.src[p.pos]
+		p.pos++
+		right, err := p.parseUnary()
+		if err != nil {
+			return 0, err
+		}
+		switch op {
+		case '*':
+			left *= right
+		case '/':
+			left /= right
+		default:
+			left = math.Mod(left, right)
+		}
+	}
+}
+
+func (p *formulaParser) parseUnary() (float64, error) {
+	p.skipSpaces()
+	if p.pos < len(p.src) && p.src[p.pos] == '-' {
+		p.pos++
+		v, err := p.parseUnary()
+		return -v, err
+	}
+	return p.parseOperand()
+}
+
+func (p *formulaParser) parseOperand() (float64, error) {
+	p.skipSpaces()
+	if p.pos >= len(p.src) {
+		return 0, fmt.Errorf("unexpected end of formula")
+	}
+
+	switch {
+	case p.src[p.pos] == '(':
+		p.pos++
+		v, err := p.parseSum()
+		if err != nil {
+			return 0, err
+		}
+		p.skipSpaces()
+		if p.pos >= len(p.src) || p.src[p.pos] != ')' {
+			return 0, fmt.Errorf("missing ) in formula")
+		}
+		p.pos++
+		return v, nil
+	case strings.HasPrefix(p.src[p.pos:], "{{"):
+		end := strings.Index(p.src[p.pos:], "}}")
+		if end < 0 {
+			return 0, fmt.Errorf("unterminated {{ in formula")
+		}
+		name := strings.TrimSpace(p.src[p.pos+2 : p.pos+end])
+		p.pos += end + 2
+		return p.attribute(name)
+	default:
+		start := p.pos
+		for p.pos < len(p.src) && (p.src[p.pos] == '.' || (p.src[p.pos] >= '0' && p.src[p.pos] <= '9')) {
+			p.pos++
+		}
+		if start == p.pos {
+			return 0, fmt.Errorf("unexpected %q at offset %d in formula", p.src[p.pos:p.pos+1], p.pos)
+		}
+		return strconv.ParseFloat(p.src[start:p.pos], 64)
+	}
+}
+
+func (p *formulaParser) attribute(name string) (float64, error) {
+	v, ok := p.event.Get(name)
+	if !ok {
+		if p.replaceMissing {
+			return 0, nil
+		}
+		return 0, &errMissingAttribute{name: name}
+	}
+	switch v := v.(type) {
+	case float64:
+		return v, nil
+	case bool:
+		if v {
+			return 1, nil
+		}
+		return 0, nil
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return 0, fmt.Errorf("attribute %q is not numeric", name)
+		}
+		return f, nil
+	default:
+		return 0, fmt.Errorf("attribute %q is not numeric", name)
+	}
+}
+
+func formatNumber(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
@@ -0,0 +1,216 @@
+package routesim
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// parseURL splits a URL into the detail fields written by the URL parser.
+func parseURL(raw string) (map[string]any, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL: %w", err)
+	}
+	if u.Scheme == "" && u.Host == "" && u.Path == "" {
+		return nil, fmt.Errorf("invalid URL %q", raw)
+	}
+
+	details := map[string]any{}
+	if u.Scheme != "" {
+		details["scheme"] = u.Scheme
+	}
+	if host := u.Hostname(); host != "" {
+		details["host"] = host
+	}
+	if port := u.Port(); port != "" {
+		if n, err := strconv.ParseFloat(port, 64); err == nil {
+			details["port"] = n
+		}
+	}
+	if u.Path != "" {
+		details["path"] = u.Path
+	}
+	if values := u.Query(); len(values) > 0 {
+		params := map[string]any{}
+		for k, v := range values {
+			if len(v) == 1 {
+				params[k] = v[0]
+				continue
+			}
+			list := make([]any, 0, len(v))
+			for _, el := range v {
+				list = append(list, el)
+			}
+			params[k] = list
+		}
+		details["queryString"] = params
+	}
+	if u.Fragment != "" {
+		details["fragment"] = u.Fragment
+	}
+	return details, nil
+}
+
+var (
+	uaBrowsers = []struct {
+		family string
+		re     *regexp.Regexp
+	}{
+		// Order matters: Chromium-based browsers also advertise Chrome and Safari.
+		{"Edge", regexp.MustCompile(`Edg(?:e|A|iOS)?/([\d.]+)`)},
+		{"Opera", regexp.MustCompile(`(?:OPR|Opera)/([\d.]+)`)},
+		{"Chrome", regexp.MustCompile(`(?:Chrome|CriOS)/([\d.]+)`)},
+		{"Firefox", regexp.MustCompile(`(?:Firefox|FxiOS)/([\d.]+)`)},
+		{"Safari", regexp.MustCompile(`Version/([\d.]+).*Safari/`)},
+		{"curl", regexp.MustCompile(`curl/([\d.]+)`)},
+	}
+	uaOS = []struct {
+		family string
+		re     *regexp.Regexp
+	}{
+		{"Windows", regexp.MustCompile(`Windows NT ([\d.]+)`)},
+		{"iOS", regexp.MustCompile(`(?:iPhone|iPad|iPod).*OS ([\d_]+)`)},
+		{"Android", regexp.MustCompile(`Android ([\d.]+)`)},
+		{"Mac OS X", regexp.MustCompile(`Mac OS X ([\d_.]+)`)},
+		{"Chrome OS", regexp.MustCompile(`CrOS \S+ ([\d.]+)`)},
+		{"Linux", regexp.MustCompile(`Linux()`)},
+	}
+	uaBot = regexp.MustCompile(`(?i)bot|crawler|spider|slurp`)
+)
+
+// parseUserAgent extracts the browser, operating system and device category of a
+// user agent string.
+func parseUserAgent(ua string) map[string]any {
+	browser := map[string]any{"family": "Other"}
+	for _, b := range uaBrowsers {
+		if m := b.re.FindStringSubmatch(ua); m != nil {
+			browser = map[string]any{"family": b.family, "version": m[1]}
+			break
+		}
+	}
+
+	os := map[string]any{"family": "Other"}
+	for _, o := range uaOS {
+		if m := o.re.FindStringSubmatch(ua); m != nil {
+			os = map[string]any{"family": o.family}
+			if m[1] != "" {
+				os["version"] = strings.ReplaceAll(m[1], "_", ".")
+			}
+			break
+		}
+	}
+
+	device := "Desktop"
+	switch {
+	case uaBot.MatchString(ua):
+		device = "Bot"
+	case strings.Contains(ua, "iPad") || strings.Contains(ua, "Tablet"):
+		device = "Tablet"
+	case strings.Contains(ua, "Mobile") || strings.Contains(ua, "iPhone"):
+		device = "Mobile"
+	case browser["family"] == "curl":
+		device = "Other"
+	}
+
+	return map[string]any{
+		"browser": browser,
+		"os":      os,
+		"device":  map[string]any{"category": device},
+	}
+}
+
+// parseKeyValues splits text into pairs with pairsSplitter and each pair into a key
+// and a value with kvSplitter. Surrounding quotes are removed from values. Keys
+// without a value are kept as true when acceptStandalone is set.
+func parseKeyValues(text, kvSplitter, pairsSplitter string, acceptStandalone bool) map[string]any {
+	if kvSplitter == "" {
+		kvSplitter = "="
+	}
+	var pairs []string
+	if pairsSplitter == "" || strings.TrimSpace(pairsSplitter) == "" {
+		pairs = strings.Fields(text)
+	} else {
+		pairs = strings.Split(text, pairsSplitter)
+	}
+
+	out := map[string]any{}
+	for _, pair := range pairs {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		key, value, found := strings.Cut(pair, kvSplitter)
+		key = strings.TrimSpace(key)
+		if key == "" {
+			continue
+		}
+		if !found {
+			if acceptStandalone {
+				out[key] = true
+			}
+			continue
+		}
+		out[key] = unquote(strings.TrimSpace(value))
+	}
+	return out
+}
+
+func unquote(s string) string {
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
+
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+	time.RFC1123Z,
+	time.RFC1123,
+	"02/Jan/2006:15:04:05 -0700",
+}
+
+// normalizeTimestamp reads an event-time attribute, either a date string or a Unix
+// epoch in seconds or milliseconds, and returns it as an RFC 3339 UTC timestamp.
+func normalizeTimestamp(v any) (string, bool) {
+	var t time.Time
+	switch v := v.(type) {
+	case float64:
+		t = fromEpoch(v)
+	case string:
+		s := strings.TrimSpace(v)
+		if n, err := strconv.ParseFloat(s, 64); err == nil {
+			t = fromEpoch(n)
+			break
+		}
+		parsed := false
+		for _, layout := range timestampLayouts {
+			if p, err := time.Parse(layout, s); err == nil {
+				t, parsed = p, true
+				break
+			}
+		}
+		if !parsed {
+			return "", false
+		}
+	default:
+		return "", false
+	}
+	return t.UTC().Format(time.RFC3339Nano), true
+}
+
+// fromEpoch treats values above 1e11 as milliseconds, which leaves seconds-based
+// timestamps unambiguous until the year 5138.
+func fromEpoch(n float64) time.Time {
+	if n > 1e11 {
+		return time.UnixMilli(int64(n))
+	}
+	sec := int64(n)
+	return time.Unix(sec, int64((n-float64(sec))*1e9))
+}
@@ -0,0 +1,415 @@
+// Package routesim is an offline interpreter of log route processors. It runs sample
+// events through the processor list that the route resource sends to the API
+// (mapper, parse-attribute, creator and nested split processors) so that pipelines
+// can be tested without ingesting logs.
+//
+// The interpreter follows the documented processor semantics. Parsers whose exact
+// output layout is decided by the ingestion engine (URL and user-agent) write a
+// best-effort subset of fields under `<source attribute>_details`.
+package routesim
+
+import (
+	"fmt"
+	"strings"
+
+	"terraform-provider-tsuga/internal/grok"
+	"terraform-provider-tsuga/internal/query"
+)
+
+// Processor is a route processor in its API representation. Params holds
+// JSON-decoded values, so nested lists are []any and objects map[string]any.
+type Processor struct {
+	ID     string         `json:"id"`
+	Type   string         `json:"type"`
+	Params map[string]any `json:"params"`
+}
+
+// Result is the outcome of running one event through a pipeline.
+type Result struct {
+	Event Event
+	// Applied lists the IDs of the processors the event went through, in order,
+	// including the split processors and the processors of the branches taken.
+	Applied []string
+	// Skipped lists processors that could not be applied to the event, with the
+	// reason, formatted as "<id>: <reason>". The pipeline continues after them.
+	Skipped []string
+}
+
+// Pipeline is a compiled processor list.
+type Pipeline struct {
+	steps []step
+}
+
+type step struct {
+	id    string
+	apply func(e Event) error
+	split []branch
+}
+
+type branch struct {
+	query    *query.Query
+	pipeline *Pipeline
+}
+
+// Compile checks the processors and prepares them for execution. Configuration errors,
+// such as an invalid Grok rule or split query, are reported with the processor ID.
+func Compile(processors []Processor) (*Pipeline, error) {
+	p := &Pipeline{}
+	for i, proc := range processors {
+		s, err := compileStep(proc)
+		if err != nil {
+			return nil, fmt.Errorf("processors[%d] (%s): %w", i, proc.ID, err)
+		}
+		p.steps = append(p.steps, s)
+	}
+	return p, nil
+}
+
+// Run transforms a copy of event through the pipeline.
+func (p *Pipeline) Run(event Event) Result {
+	result := Result{Event: event.clone()}
+	p.run(&result)
+	return result
+}
+
+func (p *Pipeline) run(result *Result) {
+	for _, s := range p.steps {
+		result.Applied = append(result.Applied, s.id)
+		if s.split != nil {
+			// A split processor ends the chain: the event continues in the first
+			// branch whose query matches, or leaves the pipeline unchanged.
+			for _, b := range s.split {
+				if b.query.Match(result.Event.Get) {
+					b.pipeline.run(result)
+					break
+				}
+			}
+			return
+		}
+		if err := s.apply(result.Event); err != nil {
+			result.Skipped = append(result.Skipped, fmt.Sprintf("%s: %s", s.id, err))
+		}
+	}
+}
+
+func compileStep(proc Processor) (step, error) {
+	s := step{id: proc.ID}
+	params := proc.Params
+	subtype := stringParam(params, "subtype")
+
+	var err error
+	switch proc.Type {
+	case "mapper":
+		s.apply, err = compileMapper(subtype, params)
+	case "parse-attribute":
+		s.apply, err = compileParser(subtype, params)
+	case "creator":
+		s.apply, err = compileCreator(subtype, params)
+	case "split":
+		s.split, err = compileSplit(params)
+	default:
+		err = fmt.Errorf("unsupported processor type %q", proc.Type)
+	}
+	return s, err
+}
+
+func compileMapper(subtype string, params map[string]any) (func(Event) error, error) {
+	switch subtype {
+	case "map-attributes":
+		mappings := objectsParam(params, "attributes")
+		return func(e Event) error {
+			for _, m := range mappings {
+				origin, target := stringParam(m, "originAttribute"), stringParam(m, "targetAttribute")
+				v, ok := e.Get(origin)
+				if !ok {
+					continue
+				}
+				if e.Has(target) && !boolParam(m, "overrideTarget", true) {
+					continue
+				}
+				if !boolParam(m, "keepOrigin", false) && origin != target {
+					e.Delete(origin)
+				}
+				e.Set(target, v)
+			}
+			return nil
+		}, nil
+	case "map-level":
+		name := stringParam(params, "attributeName")
+		return func(e Event) error {
+			v, ok := e.Get(name)
+			if !ok {
+				return fmt.Errorf("attribute %q is not set", name)
+			}
+			level, ok := normalizeLevel(v)
+			if !ok {
+				return fmt.Errorf("value %q of attribute %q is not a recognized level", stringValue(v), name)
+			}
+			e["level"] = level
+			return nil
+		}, nil
+	case "map-timestamp":
+		name := stringParam(params, "attributeName")
+		return func(e Event) error {
+			v, ok := e.Get(name)
+			if !ok {
+				return fmt.Errorf("attribute %q is not set", name)
+			}
+			ts, ok := normalizeTimestamp(v)
+			if !ok {
+				return fmt.Errorf("value %q of attribute %q is not a recognized timestamp", stringValue(v), name)
+			}
+			e["timestamp"] = ts
+			return nil
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported mapper subtype %q", subtype)
+	}
+}
+
+func compileParser(subtype string, params map[string]any) (func(Event) error, error) {
+	switch subtype {
+	case "grok":
+		name := stringParam(params, "attributeName")
+		var rules []*grok.Rule
+		for i, src := range stringsParam(params, "rules") {
+			rule, err := grok.Compile(src)
+			if err != nil {
+				return nil, fmt.Errorf("rules[%d]: %w", i, err)
+			}
+			rules = append(rules, rule)
+		}
+		return func(e Event) error {
+			text, err := stringAttribute(e, name)
+			if err != nil {
+				return err
+			}
+			extracted, ok := grok.MatchFirst(rules, text)
+			if !ok {
+				return fmt.Errorf("no Grok rule matches attribute %q", name)
+			}
+			e.merge(extracted)
+			return nil
+		}, nil
+	case "url":
+		source := stringParam(params, "sourceAttribute")
+		return func(e Event) error {
+			text, err := stringAttribute(e, source)
+			if err != nil {
+				return err
+			}
+			details, err := parseURL(text)
+			if err != nil {
+				return err
+			}
+			e.Set(source+"_details", details)
+			return nil
+		}, nil
+	case "user-agent":
+		source := stringParam(params, "sourceAttribute")
+		return func(e Event) error {
+			text, err := stringAttribute(e, source)
+			if err != nil {
+				return err
+			}
+			e.Set(source+"_details", parseUserAgent(text))
+			return nil
+		}, nil
+	case "key-value":
+		source := stringParam(params, "sourceAttribute")
+		target := stringParam(params, "targetAttribute")
+		kvSplitter := stringParam(params, "keyValueSplitter")
+		pairsSplitter := stringParam(params, "pairsSplitter")
+		standalone := boolParam(params, "acceptStandaloneKey", false)
+		return func(e Event) error {
+			text, err := stringAttribute(e, source)
+			if err != nil {
+				return err
+			}
+			pairs := parseKeyValues(text, kvSplitter, pairsSplitter, standalone)
+			if target == "" {
+				e.merge(pairs)
+				return nil
+			}
+			e.Set(target, pairs)
+			return nil
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported parse-attribute subtype %q", subtype)
+	}
+}
+
+func compileCreator(subtype string, params map[string]any) (func(Event) error, error) {
+	target := stringParam(params, "targetAttribute")
+	override := boolParam(params, "overrideTarget", true)
+
+	switch subtype {
+	case "format-string":
+		template := stringParam(params, "formatString")
+		replaceMissing := boolParam(params, "replaceMissingByEmpty", true)
+		return func(e Event) error {
+			if e.Has(target) && !override {
+				return nil
+			}
+			value, err := renderTemplate(template, e, replaceMissing)
+			if err != nil {
+				return err
+			}
+			e.Set(target, value)
+			return nil
+		}, nil
+	case "math-formula":
+		formula := stringParam(params, "formula")
+		replaceMissing := boolParam(params, "replaceMissingBy0", true)
+		return func(e Event) error {
+			if e.Has(target) && !override {
+				return nil
+			}
+			value, err := evalFormula(formula, e, replaceMissing)
+			if err != nil {
+				return err
+			}
+			e.Set(target, value)
+			return nil
+		}, nil
+	case "category":
+		type clause struct {
+			query *query.Query
+			value string
+		}
+		var clauses []clause
+		for i, c := range objectsParam(params, "clauses") {
+			q, err := query.Parse(stringParam(c, "query"))
+			if err != nil {
+				return nil, fmt.Errorf("clauses[%d].query: %w", i, err)
+			}
+			clauses = append(clauses, clause{query: q, value: stringParam(c, "value")})
+		}
+		defaultValue, hasDefault := params["defaultValue"].(string)
+		return func(e Event) error {
+			for _, c := range clauses {
+				if c.query.Match(e.Get) {
+					e.Set(target, c.value)
+					return nil
+				}
+			}
+			if hasDefault {
+				e.Set(target, defaultValue)
+			}
+			return nil
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported creator subtype %q", subtype)
+	}
+}
+
+func compileSplit(params map[string]any) ([]branch, error) {
+	items := objectsParam(params, "items")
+	branches := make([]branch, 0, len(items))
+	for i, item := range items {
+		q, err := query.Parse(stringParam(item, "query"))
+		if err != nil {
+			return nil, fmt.Errorf("items[%d].query: %w", i, err)
+		}
+		var procs []Processor
+		for _, raw := range objectsParam(item, "processors") {
+			params, _ := raw["params"].(map[string]any)
+			procs = append(procs, Processor{ID: stringParam(raw, "id"), Type: stringParam(raw, "type"), Params: params})
+		}
+		pipeline, err := Compile(procs)
+		if err != nil {
+			return nil, fmt.Errorf("items[%d].%w", i, err)
+		}
+		branches = append(branches, branch{query: q, pipeline: pipeline})
+	}
+	return branches, nil
+}
+
+func stringAttribute(e Event, name string) (string, error) {
+	v, ok := e.Get(name)
+	if !ok {
+		return "", fmt.Errorf("attribute %q is not set", name)
+	}
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("attribute %q is not a string", name)
+	}
+	return s, nil
+}
+
+func stringParam(params map[string]any, key string) string {
+	s, _ := params[key].(string)
+	return s
+}
+
+func boolParam(params map[string]any, key string, fallback bool) bool {
+	if b, ok := params[key].(bool); ok {
+		return b
+	}
+	return fallback
+}
+
+func stringsParam(params map[string]any, key string) []string {
+	raw, _ := params[key].([]any)
+	out := make([]string, 0, len(raw))
+	for _, v := range raw {
+		if s, ok := v.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+func objectsParam(params map[string]any, key string) []map[string]any {
+	raw, _ := params[key].([]any)
+	out := make([]map[string]any, 0, len(raw))
+	for _, v := range raw {
+		if m, ok := v.(map[string]any); ok {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+// normalizeLevel maps a severity or status value onto the Tsuga levels, CRITICAL to
+// TRACE. Numbers are read as syslog severities (0 to 7).
+func normalizeLevel(v any) (string, bool) {
+	if n, ok := v.(float64); ok {
+		switch {
+		case n >= 0 && n <= 2:
+			return "CRITICAL", true
+		case n == 3:
+			return "ERROR", true
+		case n == 4:
+			return "WARN", true
+		case n == 5:
+			return "NOTICE", true
+		case n == 6:
+			return "INFO", true
+		case n == 7:
+			return "DEBUG", true
+		}
+		return "", false
+	}
+	s, ok := v.(string)
+	if !ok {
+		return "", false
+	}
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "critical", "crit", "fatal", "panic", "emerg", "emergency", "alert":
+		return "CRITICAL", true
+	case "error", "err", "severe":
+		return "ERROR", true
+	case "warn", "warning":
+		return "WARN", true
+	case "notice":
+		return "NOTICE", true
+	case "info", "information", "informational", "ok":
+		return "INFO", true
+	case "debug":
+		return "DEBUG", true
+	case "trace", "verbose":
+		return "TRACE", true
+	}
+	return "", false
+}
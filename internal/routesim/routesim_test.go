@@ -0,0 +1,190 @@
+package routesim
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func decodeProcessors(t *testing.T, src string) []Processor {
+	t.Helper()
+	var procs []Processor
+	if err := json.Unmarshal([]byte(src), &procs); err != nil {
+		t.Fatalf("invalid processors: %v", err)
+	}
+	return procs
+}
+
+func decodeEvent(t *testing.T, src string) Event {
+	t.Helper()
+	var e Event
+	if err := json.Unmarshal([]byte(src), &e); err != nil {
+		t.Fatalf("invalid event: %v", err)
+	}
+	return e
+}
+
+const pipelineJSON = `[
+  {"id": "msg", "type": "mapper", "params": {"subtype": "map-attributes", "attributes": [
+    {"originAttribute": "msg", "targetAttribute": "message", "keepOrigin": false, "overrideTarget": true}
+  ]}},
+  {"id": "nginx", "type": "parse-attribute", "params": {"subtype": "grok", "attributeName": "message", "rules": [
+    "\\[%{WORD:severity}\\] %{WORD:http.method} %{NOTSPACE:http.url} %{INT:http.status_code:integer} %{NUMBER:intake.latency_s:number}"
+  ]}},
+  {"id": "latency", "type": "creator", "params": {"subtype": "math-formula", "targetAttribute": "latency",
+    "formula": "{{intake.latency_s}} * 1000 + {{store.latency_ms}}", "overrideTarget": true, "replaceMissingBy0": true}},
+  {"id": "summary", "type": "creator", "params": {"subtype": "format-string", "targetAttribute": "summary",
+    "formatString": "{{http.method}} {{http.url}} -> {{http.status_code}}", "overrideTarget": true, "replaceMissingByEmpty": true}},
+  {"id": "class", "type": "creator", "params": {"subtype": "category", "targetAttribute": "class", "clauses": [
+    {"query": "http.status_code:>=500", "value": "error"},
+    {"query": "http.status_code:>=400", "value": "warning"}
+  ], "defaultValue": "ok"}},
+  {"id": "splitter", "type": "split", "params": {"items": [
+    {"query": "class:error", "processors": [
+      {"id": "level", "type": "mapper", "params": {"subtype": "map-level", "attributeName": "severity"}},
+      {"id": "nested", "type": "split", "params": {"items": [
+        {"query": "http.url:/api*", "processors": [
+          {"id": "kv", "type": "parse-attribute", "params": {"subtype": "key-value", "sourceAttribute": "extra",
+            "targetAttribute": "extra_fields", "keyValueSplitter": "=", "pairsSplitter": " "}}
+        ]}
+      ]}}
+    ]},
+    {"query": "*", "processors": [
+      {"id": "url", "type": "parse-attribute", "params": {"subtype": "url", "sourceAttribute": "http.url"}}
+    ]}
+  ]}}
+]`
+
+func TestPipelineRun(t *testing.T) {
+	pipeline, err := Compile(decodeProcessors(t, pipelineJSON))
+	if err != nil {
+		t.Fatalf("unexpected compile error: %v", err)
+	}
+
+	result := pipeline.Run(decodeEvent(t, `{"msg": "[err] GET /api/pay 503 0.25", "extra": "user=bob region=\"eu-west\"", "store": {"latency_ms": 4}}`))
+	want := decodeEvent(t, `{
+		"message": "[err] GET /api/pay 503 0.25",
+		"severity": "err",
+		"http": {"method": "GET", "url": "/api/pay", "status_code": 503},
+		"intake": {"latency_s": 0.25},
+		"store": {"latency_ms": 4},
+		"latency": 254,
+		"summary": "GET /api/pay -> 503",
+		"class": "error",
+		"level": "ERROR",
+		"extra": "user=bob region=\"eu-west\"",
+		"extra_fields": {"user": "bob", "region": "eu-west"}
+	}`)
+	// Grok integer filters produce int64; compare through JSON.
+	if got, _ := json.Marshal(result.Event); !jsonEqual(t, got, want) {
+		t.Errorf("unexpected event:\n got %s", got)
+	}
+	if wantApplied := []string{"msg", "nginx", "latency", "summary", "class", "splitter", "level", "nested", "kv"}; !reflect.DeepEqual(result.Applied, wantApplied) {
+		t.Errorf("applied = %v, want %v", result.Applied, wantApplied)
+	}
+	if len(result.Skipped) != 0 {
+		t.Errorf("unexpected skipped processors: %v", result.Skipped)
+	}
+
+	fallback := pipeline.Run(decodeEvent(t, `{"msg": "[info] GET https://example.com:8443/home?a=1 200 0.1"}`))
+	details, _ := fallback.Event.Get("http.url_details")
+	wantDetails := map[string]any{"scheme": "https", "host": "example.com", "port": float64(8443), "path": "/home", "queryString": map[string]any{"a": "1"}}
+	if !reflect.DeepEqual(details, wantDetails) {
+		t.Errorf("url details = %#v, want %#v", details, wantDetails)
+	}
+	if class, _ := fallback.Event.Get("class"); class != "ok" {
+		t.Errorf("class = %v, want ok", class)
+	}
+}
+
+func jsonEqual(t *testing.T, got []byte, want Event) bool {
+	t.Helper()
+	var decoded any
+	if err := json.Unmarshal(got, &decoded); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	return reflect.DeepEqual(decoded, map[string]any(want))
+}
+
+func TestPipelineSkipsFailingProcessors(t *testing.T) {
+	pipeline, err := Compile(decodeProcessors(t, `[
+	  {"id": "fmt", "type": "creator", "params": {"subtype": "format-string", "targetAttribute": "out", "formatString": "{{missing}}", "replaceMissingByEmpty": false}},
+	  {"id": "level", "type": "mapper", "params": {"subtype": "map-level", "attributeName": "sev"}},
+	  {"id": "keep", "type": "creator", "params": {"subtype": "format-string", "targetAttribute": "sev", "formatString": "x", "overrideTarget": false}}
+	]`))
+	if err != nil {
+		t.Fatalf("unexpected compile error: %v", err)
+	}
+
+	result := pipeline.Run(Event{"sev": "loud"})
+	if len(result.Skipped) != 2 || !strings.HasPrefix(result.Skipped[0], "fmt: ") || !strings.HasPrefix(result.Skipped[1], "level: ") {
+		t.Errorf("unexpected skipped processors: %v", result.Skipped)
+	}
+	if result.Event["sev"] != "loud" || result.Event.Has("out") || result.Event.Has("level") {
+		t.Errorf("unexpected event: %v", result.Event)
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	cases := map[string]string{
+		`[{"id": "g", "type": "parse-attribute", "params": {"subtype": "grok", "attributeName": "m", "rules": ["%{NOPE:x}"]}}]`: `processors[0] (g): rules[0]: unknown pattern "NOPE"`,
+		`[{"id": "s", "type": "split", "params": {"items": [{"query": "a:(", "processors": []}]}}]`:                             `processors[0] (s): items[0].query: column 4`,
+		`[{"id": "x", "type": "enricher", "params": {}}]`:                                                                       `unsupported processor type "enricher"`,
+	}
+	for src, want := range cases {
+		_, err := Compile(decodeProcessors(t, src))
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("Compile(%s): got %v, want error containing %q", src, err, want)
+		}
+	}
+}
+
+func TestEvalFormula(t *testing.T) {
+	e := Event{"a": float64(3), "b": "4", "nested": map[string]any{"c": float64(2)}}
+	cases := map[string]float64{
+		"{{a}} + {{b}} * 2":          11,
+		"({{a}} + {{b}}) * 2":        14,
+		"-{{nested.c}} + 10 % 4":     0,
+		"{{missing}} + 1":            1,
+		"{{ a }} / {{nested.c}} - 1": 0.5,
+	}
+	for formula, want := range cases {
+		got, err := evalFormula(formula, e, true)
+		if err != nil || got != want {
+			t.Errorf("evalFormula(%q) = %v, %v; want %v", formula, got, err, want)
+		}
+	}
+	if _, err := evalFormula("{{missing}} + 1", e, false); err == nil {
+		t.Errorf("expected missing attribute error")
+	}
+	if _, err := evalFormula("{{a}} / 0", e, true); err == nil {
+		t.Errorf("expected error for division by zero")
+	}
+}
+
+func TestNormalizeTimestamp(t *testing.T) {
+	cases := map[any]string{
+		"2026-08-13T10:00:00+02:00":  "2026-08-13T08:00:00Z",
+		float64(1786608000):          "2026-08-13T08:00:00Z",
+		float64(1786608000500):       "2026-08-13T08:00:00.5Z",
+		"13/Aug/2026:10:00:00 +0200": "2026-08-13T08:00:00Z",
+	}
+	for in, want := range cases {
+		if got, ok := normalizeTimestamp(in); !ok || got != want {
+			t.Errorf("normalizeTimestamp(%v) = %q, %v; want %q", in, got, ok, want)
+		}
+	}
+}
+
+func TestParseUserAgent(t *testing.T) {
+	got := parseUserAgent("Mozilla/5.0 (iPhone; CPU iPhone OS 17_4 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.4 Mobile/15E148 Safari/604.1")
+	want := map[string]any{
+		"browser": map[string]any{"family": "Safari", "version": "17.4"},
+		"os":      map[string]any{"family": "iOS", "version": "17.4"},
+		"device":  map[string]any{"category": "Mobile"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseUserAgent() = %#v, want %#v", got, want)
+	}
+}